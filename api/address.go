@@ -0,0 +1,39 @@
+package api
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/trustwallet/blockatlas/pkg/address"
+	"github.com/trustwallet/blockatlas/pkg/blockatlas"
+	"github.com/trustwallet/blockatlas/pkg/ginutils"
+)
+
+type AddressValidation struct {
+	Valid bool `json:"valid"`
+	// Address is the canonical form of a valid address
+	Address string `json:"address,omitempty"`
+}
+
+// @Summary Validate an address
+// @ID validate_address
+// @Description Check the address format of the coin and get the canonical form of the address
+// @Produce json
+// @Tags Address
+// @Param coin path string true "the coin name" default(ethereum)
+// @Param address path string true "the address" default(0xfc10cab6a50a1ab10c56983c80cc82afc6559cf1)
+// @Success 200 {object} api.AddressValidation
+// @Router /v2/{coin}/address/{address}/validate [get]
+func makeAddressValidateRoute(router gin.IRouter, api blockatlas.Platform) {
+	coinID := api.Coin().ID
+	if !address.IsSupported(coinID) {
+		return
+	}
+
+	router.GET("/address/:address/validate", func(c *gin.Context) {
+		normalized, err := address.Normalize(coinID, c.Param("address"))
+		if err != nil {
+			ginutils.RenderSuccess(c, AddressValidation{Valid: false})
+			return
+		}
+		ginutils.RenderSuccess(c, AddressValidation{Valid: true, Address: normalized})
+	})
+}
@@ -0,0 +1,104 @@
+package api
+
+import (
+	"context"
+	"github.com/gin-gonic/gin"
+	"github.com/trustwallet/blockatlas/coin"
+	"github.com/trustwallet/blockatlas/pkg/blockatlas"
+	"github.com/trustwallet/blockatlas/pkg/ginutils"
+	"github.com/trustwallet/blockatlas/pkg/logger"
+	"github.com/trustwallet/blockatlas/platform"
+)
+
+// @Summary Get Balance
+// @ID balance
+// @Description Get the native and the token balances of the address
+// @Accept json
+// @Produce json
+// @Tags Balance
+// @Param coin path string true "the coin name" default(tron)
+// @Param address path string true "the query address" default(TMuA6YqfCeX8EhbfYEg5y7S4DqzSJireY9)
+// @Param token query string false "the token id, only the balance of this token is returned"
+// @Success 200 {object} blockatlas.Balance
+// @Failure 500 {object} ginutils.ApiError
+// @Router /v2/{coin}/balance/{address} [get]
+func makeBalanceRoute(router gin.IRouter, api blockatlas.Platform) {
+	var balanceAPI blockatlas.BalanceAPI
+	balanceAPI, _ = api.(blockatlas.BalanceAPI)
+
+	if balanceAPI == nil {
+		return
+	}
+
+	router.GET("/balance/:address", func(c *gin.Context) {
+		balance, err := getBalance(c.Request.Context(), balanceAPI, c.Param("address"), c.Query("token"))
+		if err != nil {
+			renderError(c, err)
+			return
+		}
+		ginutils.RenderSuccess(c, balance)
+	})
+}
+
+// @Summary Get Multiple Balances
+// @ID batch_balances
+// @Description Get the native and the token balances for multiple addresses and coins
+// @Accept json
+// @Produce json
+// @Tags Balance
+// @Param balances body api.AddressesRequest true "Addresses and coins"
+// @Success 200 {object} blockatlas.BalancePage
+// @Router /v2/balances [post]
+func makeBalancesBatchRoute(router gin.IRouter) {
+	router.POST("/balances", func(c *gin.Context) {
+		var reqs AddressesRequest
+		if err := c.BindJSON(&reqs); err != nil {
+			ginutils.ErrorResponse(c).Message(err.Error()).Render()
+			return
+		}
+
+		ctx := c.Request.Context()
+		batch := make(blockatlas.BalancePage, 0)
+		for _, r := range reqs {
+			c, ok := coin.Coins[r.Coin]
+			if !ok {
+				continue
+			}
+			p, ok := platform.BalanceAPIs[c.Handle]
+			if !ok {
+				continue
+			}
+			balance, err := getBalance(ctx, p, r.Address, "")
+			if err != nil {
+				logger.Error(err, logger.Params{"coin": r.Coin, "address": r.Address})
+				continue
+			}
+			batch = append(batch, *balance)
+		}
+		ginutils.RenderSuccess(c, blockatlas.DocsResponse{Docs: batch})
+	})
+}
+
+// getBalance returns the balance of the address, with the token set only the balance of this token is kept
+func getBalance(ctx context.Context, p blockatlas.BalanceAPI, address, token string) (*blockatlas.Balance, error) {
+	balance, err := blockatlas.BalanceAPIContext(p).GetBalanceWithContext(ctx, address)
+	if err != nil || token == "" {
+		return balance, err
+	}
+
+	if tokenAPI, ok := p.(blockatlas.TokenBalanceAPI); ok {
+		tokenBalance, err := blockatlas.TokenBalanceAPIContext(tokenAPI).GetTokenBalanceWithContext(ctx, address, token)
+		if err != nil {
+			return nil, err
+		}
+		balance.Tokens = []blockatlas.TokenBalance{*tokenBalance}
+		return balance, nil
+	}
+
+	tokenBalance, ok := balance.FindToken(token)
+	if !ok {
+		tokenBalance = blockatlas.TokenBalance{TokenID: token, Balance: "0"}
+	}
+	balance.Tokens = []blockatlas.TokenBalance{tokenBalance}
+	return balance, nil
+}
@@ -0,0 +1,62 @@
+package api
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/trustwallet/blockatlas/pkg/blockatlas"
+	"github.com/trustwallet/blockatlas/pkg/ginutils"
+	"github.com/trustwallet/blockatlas/pkg/logger"
+	"net/http"
+)
+
+type BroadcastRequest struct {
+	Raw string `json:"raw" binding:"required"`
+}
+
+// @Summary Broadcast Transaction
+// @ID broadcast
+// @Description Broadcast a signed transaction
+// @Accept json
+// @Produce json
+// @Tags Transactions
+// @Param coin path string true "the coin name" default(cosmos)
+// @Param transaction body api.BroadcastRequest true "The signed transaction in the chain encoding"
+// @Success 200 {object} blockatlas.BroadcastResult
+// @Failure 400 {object} ginutils.ApiError
+// @Failure 409 {object} ginutils.ApiError
+// @Router /v2/{coin}/transactions/broadcast [post]
+func makeBroadcastRoute(router gin.IRouter, api blockatlas.Platform) {
+	var broadcastAPI blockatlas.BroadcastAPI
+	broadcastAPI, _ = api.(blockatlas.BroadcastAPI)
+
+	if broadcastAPI == nil {
+		return
+	}
+
+	router.POST("/transactions/broadcast", func(c *gin.Context) {
+		var req BroadcastRequest
+		if err := c.BindJSON(&req); err != nil {
+			ginutils.RenderError(c, http.StatusBadRequest, err.Error())
+			return
+		}
+		id, err := blockatlas.BroadcastAPIContext(broadcastAPI).BroadcastTransactionWithContext(c.Request.Context(), req.Raw)
+		if err != nil {
+			renderBroadcastError(c, err)
+			return
+		}
+		ginutils.RenderSuccess(c, blockatlas.BroadcastResult{ID: id})
+	})
+}
+
+func renderBroadcastError(c *gin.Context, err error) {
+	bErr, ok := err.(*blockatlas.BroadcastError)
+	if !ok {
+		logger.Error(err, "Broadcast failed")
+		renderError(c, err)
+		return
+	}
+	code := http.StatusBadRequest
+	if bErr.Err == blockatlas.ErrTxAlreadyKnown {
+		code = http.StatusConflict
+	}
+	ginutils.RenderError(c, code, bErr.Error())
+}
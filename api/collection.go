@@ -31,7 +31,7 @@ func oldMakeCollectionsRoute(router gin.IRouter, api blockatlas.Platform) {
 	router.GET("/collections/:owner", func(c *gin.Context) {
 		collections, err := collectionAPI.OldGetCollections(c.Param("owner"))
 		if err != nil {
-			ginutils.ErrorResponse(c).Message(err.Error()).Render()
+			renderError(c, err)
 			return
 		}
 
@@ -61,7 +61,7 @@ func makeCollectionsRoute(router gin.IRouter, api blockatlas.Platform) {
 	router.GET("/collections/:owner", func(c *gin.Context) {
 		collections, err := collectionAPI.GetCollections(c.Param("owner"))
 		if err != nil {
-			ginutils.ErrorResponse(c).Message(err.Error()).Render()
+			renderError(c, err)
 			return
 		}
 
@@ -93,7 +93,7 @@ func oldMakeCollectionRoute(router gin.IRouter, api blockatlas.Platform) {
 	router.GET("/collections/:owner/collection/:collection_id", func(c *gin.Context) {
 		collectibles, err := collectionAPI.OldGetCollectibles(c.Param("owner"), c.Param("collection_id"))
 		if err != nil {
-			ginutils.ErrorResponse(c).Message(err.Error()).Render()
+			renderError(c, err)
 			return
 		}
 
@@ -124,7 +124,7 @@ func makeCollectionRoute(router gin.IRouter, api blockatlas.Platform) {
 	router.GET("/collections/:owner/collection/:collection_id", func(c *gin.Context) {
 		collectibles, err := collectionAPI.GetCollectibles(c.Param("owner"), c.Param("collection_id"))
 		if err != nil {
-			ginutils.ErrorResponse(c).Message(err.Error()).Render()
+			renderError(c, err)
 			return
 		}
 
@@ -146,7 +146,7 @@ func oldMakeCategoriesBatchRoute(router gin.IRouter) {
 	router.POST("/collectibles/categories", func(c *gin.Context) {
 		var reqs map[string][]string
 		if err := c.BindJSON(&reqs); err != nil {
-			ginutils.ErrorResponse(c).Message(err.Error()).Render()
+			renderError(c, err)
 			return
 		}
 
@@ -185,7 +185,7 @@ func makeCategoriesBatchRoute(router gin.IRouter) {
 	router.POST("/collectibles/categories", func(c *gin.Context) {
 		var reqs map[string][]string
 		if err := c.BindJSON(&reqs); err != nil {
-			ginutils.ErrorResponse(c).Message(err.Error()).Render()
+			renderError(c, err)
 			return
 		}
 
@@ -224,7 +224,7 @@ func makeCategoriesBatchRouteV4(router gin.IRouter) {
 	router.POST("/collectibles/categories", func(c *gin.Context) {
 		var reqs map[string][]string
 		if err := c.BindJSON(&reqs); err != nil {
-			ginutils.ErrorResponse(c).Message(err.Error()).Render()
+			renderError(c, err)
 			return
 		}
 
@@ -273,7 +273,7 @@ func makeCollectionRouteV4(router gin.IRouter, api blockatlas.Platform) {
 	router.GET("/collections/:owner/collection/:collection_id", func(c *gin.Context) {
 		collectibles, err := collectionAPI.GetCollectiblesV4(c.Param("owner"), c.Param("collection_id"))
 		if err != nil {
-			ginutils.ErrorResponse(c).Message(err.Error()).Render()
+			renderError(c, err)
 			return
 		}
 
@@ -0,0 +1,42 @@
+package api
+
+import (
+	"errors"
+	"github.com/gin-gonic/gin"
+	"github.com/trustwallet/blockatlas/pkg/blockatlas"
+	pkgerrors "github.com/trustwallet/blockatlas/pkg/errors"
+	"github.com/trustwallet/blockatlas/pkg/ginutils"
+	"github.com/trustwallet/blockatlas/pkg/logger"
+	"math"
+	"net/http"
+	"strconv"
+)
+
+// renderError renders the error of a platform with the status of its cause: unknown resources are 404,
+// rate limited upstream calls 429, upstream outages 503 and the requests rejected by the upstream API 400.
+// The other errors are internal server errors, their details are logged instead of being rendered
+// because they may contain the urls of the explorers with their API keys
+func renderError(c *gin.Context, err error) {
+	errResp := ginutils.ErrorResponse(c)
+	upstreamErr := blockatlas.AsUpstreamError(err)
+	switch {
+	case errors.Is(err, blockatlas.ErrInvalidAddr):
+		errResp.Params(http.StatusBadRequest, "Invalid address")
+	case errors.Is(err, blockatlas.ErrNotFound):
+		errResp.Params(http.StatusNotFound, "Not found")
+	case upstreamErr != nil && upstreamErr.Kind == blockatlas.UpstreamRateLimited:
+		if upstreamErr.RetryAfter > 0 {
+			c.Header("Retry-After", strconv.Itoa(int(math.Ceil(upstreamErr.RetryAfter.Seconds()))))
+		}
+		errResp.Params(http.StatusTooManyRequests, "Rate limited by the blockchain API")
+	case upstreamErr != nil && upstreamErr.Kind == blockatlas.UpstreamBadRequest:
+		errResp.Params(http.StatusBadRequest, "Request rejected by the blockchain API")
+	case errors.Is(err, blockatlas.ErrSourceConn),
+		errors.Is(err, blockatlas.ErrCircuitOpen),
+		pkgerrors.Is(err, pkgerrors.TypePlatformRequest):
+		errResp.Params(http.StatusServiceUnavailable, "Lost connection to blockchain")
+	default:
+		logger.Error(err, "Platform request failed", logger.Params{"path": c.FullPath()})
+	}
+	errResp.Render()
+}
@@ -0,0 +1,57 @@
+package api
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/trustwallet/blockatlas/pkg/blockatlas"
+	"github.com/trustwallet/blockatlas/pkg/ginutils"
+	"github.com/trustwallet/blockatlas/pkg/ginutils/gincache"
+	"math/big"
+	"net/http"
+	"time"
+)
+
+// @Summary Get Fee Estimates
+// @ID fee
+// @Description Get the fast, normal and slow fee estimates in the smallest unit of the coin.
+// @Description The transaction params are optional, they are used to estimate the gas limit.
+// @Accept json
+// @Produce json
+// @Tags Transactions
+// @Param coin path string true "the coin name" default(bitcoin)
+// @Param from query string false "the sender address"
+// @Param to query string false "the recipient or contract address"
+// @Param value query string false "the amount in the smallest unit"
+// @Param data query string false "the hex encoded call data"
+// @Success 200 {object} blockatlas.Fee
+// @Failure 400 {object} ginutils.ApiError
+// @Failure 500 {object} ginutils.ApiError
+// @Router /v2/{coin}/fee [get]
+func makeFeeRoute(router gin.IRouter, api blockatlas.Platform) {
+	var feeAPI blockatlas.FeeAPI
+	feeAPI, _ = api.(blockatlas.FeeAPI)
+
+	if feeAPI == nil {
+		return
+	}
+
+	router.GET("/fee", gincache.CacheMiddleware(time.Second*30, func(c *gin.Context) {
+		req := blockatlas.FeeRequest{
+			From:  c.Query("from"),
+			To:    c.Query("to"),
+			Value: c.Query("value"),
+			Data:  c.Query("data"),
+		}
+		if req.Value != "" {
+			if value, ok := new(big.Int).SetString(req.Value, 10); !ok || value.Sign() < 0 {
+				ginutils.RenderError(c, http.StatusBadRequest, "Invalid value")
+				return
+			}
+		}
+		fee, err := blockatlas.FeeAPIContext(feeAPI).GetFeeWithContext(c.Request.Context(), req)
+		if err != nil {
+			renderError(c, err)
+			return
+		}
+		ginutils.RenderSuccess(c, fee)
+	}))
+}
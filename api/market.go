@@ -0,0 +1,134 @@
+package api
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/trustwallet/blockatlas/pkg/blockatlas"
+	"github.com/trustwallet/blockatlas/pkg/ginutils"
+	"github.com/trustwallet/blockatlas/pkg/ginutils/gincache"
+	"github.com/trustwallet/blockatlas/pkg/logger"
+	"github.com/trustwallet/blockatlas/services/markets"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+const defaultChartPeriod = time.Hour * 24
+
+type TickerRequest struct {
+	Currency string          `json:"currency"`
+	Assets   []markets.Asset `json:"assets"`
+}
+
+// SetupMarketAPI registers the market data routes, the tickers and rates are read from the storage
+// filled by the market observer
+func SetupMarketAPI(root gin.IRouter, provider markets.Provider, storage markets.Storage, auth string) {
+	router := root.Group("/v1/market", ginutils.TokenAuthMiddleware(auth))
+	makeTickerRoute(router, storage)
+	makeChartsRoute(router, provider, storage)
+	makeCoinInfoRoute(router, provider, storage)
+}
+
+// @Summary Get ticker values for the list of coins and tokens
+// @ID ticker
+// @Description Get the ticker values converted to the currency
+// @Accept json
+// @Produce json
+// @Tags Market
+// @Param tickers body api.TickerRequest true "Currency and the list of assets"
+// @Success 200 {object} blockatlas.TickerResponse
+// @Failure 400 {object} ginutils.ApiError
+// @Router /v1/market/ticker [post]
+func makeTickerRoute(router gin.IRouter, storage markets.Storage) {
+	router.POST("/ticker", func(c *gin.Context) {
+		req := TickerRequest{Currency: blockatlas.DefaultCurrency}
+		if err := c.BindJSON(&req); err != nil {
+			ginutils.ErrorResponse(c).Message(err.Error()).Render()
+			return
+		}
+		result, err := markets.GetTickers(storage, req.Currency, req.Assets)
+		if err != nil {
+			renderMarketError(c, err)
+			return
+		}
+		ginutils.RenderSuccess(c, result)
+	})
+}
+
+// @Summary Get the price history of a coin or token
+// @ID charts
+// @Description Get the price history since time_start converted to the currency
+// @Produce json
+// @Tags Market
+// @Param coin query int true "Coin id" default(60)
+// @Param token query string false "Token id"
+// @Param time_start query int false "Start of the period as a unix timestamp, one day ago by default"
+// @Param currency query string false "The currency of the prices" default(USD)
+// @Success 200 {object} blockatlas.ChartData
+// @Failure 400 {object} ginutils.ApiError
+// @Router /v1/market/charts [get]
+func makeChartsRoute(router gin.IRouter, provider markets.Provider, storage markets.Storage) {
+	router.GET("/charts", gincache.CacheMiddleware(time.Minute*5, func(c *gin.Context) {
+		coin, err := strconv.ParseUint(c.Query("coin"), 10, 64)
+		if err != nil {
+			ginutils.RenderError(c, http.StatusBadRequest, "Invalid coin")
+			return
+		}
+		timeStart := time.Now().Add(-defaultChartPeriod).Unix()
+		if s := c.Query("time_start"); s != "" {
+			timeStart, err = strconv.ParseInt(s, 10, 64)
+			if err != nil {
+				ginutils.RenderError(c, http.StatusBadRequest, "Invalid time_start")
+				return
+			}
+		}
+		currency := c.DefaultQuery("currency", blockatlas.DefaultCurrency)
+
+		chart, err := markets.GetChartData(provider, storage, uint(coin), c.Query("token"), currency, timeStart)
+		if err != nil {
+			renderMarketError(c, err)
+			return
+		}
+		ginutils.RenderSuccess(c, chart)
+	}))
+}
+
+// @Summary Get the market info of a coin or token
+// @ID coin_info
+// @Description Get the market capitalization, volume and supply converted to the currency
+// @Produce json
+// @Tags Market
+// @Param coin query int true "Coin id" default(60)
+// @Param token query string false "Token id"
+// @Param currency query string false "The currency of the values" default(USD)
+// @Success 200 {object} blockatlas.ChartCoinInfo
+// @Failure 400 {object} ginutils.ApiError
+// @Router /v1/market/info [get]
+func makeCoinInfoRoute(router gin.IRouter, provider markets.Provider, storage markets.Storage) {
+	router.GET("/info", gincache.CacheMiddleware(time.Minute*5, func(c *gin.Context) {
+		coin, err := strconv.ParseUint(c.Query("coin"), 10, 64)
+		if err != nil {
+			ginutils.RenderError(c, http.StatusBadRequest, "Invalid coin")
+			return
+		}
+		currency := c.DefaultQuery("currency", blockatlas.DefaultCurrency)
+
+		info, err := markets.GetCoinInfo(provider, storage, uint(coin), c.Query("token"), currency)
+		if err != nil {
+			renderMarketError(c, err)
+			return
+		}
+		ginutils.RenderSuccess(c, info)
+	}))
+}
+
+func renderMarketError(c *gin.Context, err error) {
+	switch err {
+	case markets.ErrUnknownCurrency:
+		ginutils.RenderError(c, http.StatusBadRequest, "Unknown currency")
+	case markets.ErrUnknownAsset:
+		ginutils.RenderError(c, http.StatusNotFound, "Unknown coin or token")
+	default:
+		logger.Error(err)
+		ginutils.ErrorResponse(c).Message(err.Error()).Render()
+	}
+}
@@ -14,6 +14,7 @@ var routers = make(map[string]gin.IRouter)
 func SetupPlatformAPI(root gin.IRouter) {
 	root.GET("/", GetRoot)
 	root.GET("/status", GetStatus)
+	root.GET("/status/endpoints", GetEndpointsStatus)
 
 	v1 := root.Group("/v1")
 	v2 := root.Group("/v2")
@@ -28,6 +29,7 @@ func SetupPlatformAPI(root gin.IRouter) {
 
 		routerV2 := getRouter(v2, txAPI.Coin().Handle)
 		makeTxRouteV2(routerV2, txAPI)
+		makeTxByHashRoute(routerV2, txAPI)
 	}
 
 	for _, tokenAPI := range platform.Platforms {
@@ -35,6 +37,26 @@ func SetupPlatformAPI(root gin.IRouter) {
 		makeTokenRoute(router, tokenAPI)
 	}
 
+	for _, balanceAPI := range platform.Platforms {
+		router := getRouter(v2, balanceAPI.Coin().Handle)
+		makeBalanceRoute(router, balanceAPI)
+	}
+
+	for _, broadcastAPI := range platform.Platforms {
+		router := getRouter(v2, broadcastAPI.Coin().Handle)
+		makeBroadcastRoute(router, broadcastAPI)
+	}
+
+	for _, addressAPI := range platform.Platforms {
+		router := getRouter(v2, addressAPI.Coin().Handle)
+		makeAddressValidateRoute(router, addressAPI)
+	}
+
+	for _, feeAPI := range platform.Platforms {
+		router := getRouter(v2, feeAPI.Coin().Handle)
+		makeFeeRoute(router, feeAPI)
+	}
+
 	for _, stakeAPI := range platform.Platforms {
 		router := getRouter(v2, stakeAPI.Coin().Handle)
 		makeStakingValidatorsRoute(router, stakeAPI)
@@ -70,6 +92,7 @@ func SetupPlatformAPI(root gin.IRouter) {
 	makeCategoriesBatchRouteV4(v4)
 	makeStakingDelegationsBatchRoute(v2)
 	makeStakingDelegationsSimpleBatchRoute(v2)
+	makeBalancesBatchRoute(v2)
 
 	logger.Info("Routes set up", logger.Params{"routes": len(routers)})
 }
@@ -107,3 +130,8 @@ func GetStatus(c *gin.Context) {
 		"date":   internal.Date,
 	})
 }
+
+// GetEndpointsStatus renders the upstream endpoints of every coin and which one is active
+func GetEndpointsStatus(c *gin.Context) {
+	ginutils.RenderSuccess(c, platform.EndpointsStatus())
+}
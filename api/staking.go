@@ -1,13 +1,13 @@
 package api
 
 import (
+	"context"
 	"github.com/gin-gonic/gin"
 	"github.com/trustwallet/blockatlas/coin"
 	"github.com/trustwallet/blockatlas/pkg/blockatlas"
 	"github.com/trustwallet/blockatlas/pkg/errors"
 	"github.com/trustwallet/blockatlas/pkg/ginutils"
 	"github.com/trustwallet/blockatlas/pkg/ginutils/gincache"
-	"github.com/trustwallet/blockatlas/pkg/logger"
 	"github.com/trustwallet/blockatlas/platform"
 	services "github.com/trustwallet/blockatlas/services/assets"
 	"time"
@@ -49,6 +49,7 @@ func makeStakingDelegationsBatchRoute(router gin.IRouter) {
 			return
 		}
 
+		ctx := c.Request.Context()
 		batch := make(blockatlas.DelegationsBatchPage, 0)
 		for _, r := range reqs {
 			c, ok := coin.Coins[r.Coin]
@@ -59,7 +60,7 @@ func makeStakingDelegationsBatchRoute(router gin.IRouter) {
 			if !ok {
 				continue
 			}
-			delegation, err := getDelegationResponse(p, r.Address)
+			delegation, err := getDelegationResponse(ctx, p, r.Address)
 			if err != nil {
 				continue
 			}
@@ -122,10 +123,9 @@ func makeStakingValidatorsRoute(router gin.IRouter, api blockatlas.Platform) {
 	}
 
 	router.GET("/staking/validators", gincache.CacheMiddleware(time.Hour, func(c *gin.Context) {
-		results, err := services.GetActiveValidators(stakingAPI)
+		results, err := services.GetActiveValidators(c.Request.Context(), stakingAPI)
 		if err != nil {
-			logger.Error(err)
-			ginutils.ErrorResponse(c).Message(err.Error()).Render()
+			renderError(c, err)
 			return
 		}
 		ginutils.RenderSuccess(c, blockatlas.DocsResponse{Docs: results})
@@ -152,9 +152,9 @@ func makeStakingDelegationsRoute(router gin.IRouter, api blockatlas.Platform) {
 	}
 
 	router.GET("/staking/delegations/:address", func(c *gin.Context) {
-		response, err := getDelegationResponse(stakingAPI, c.Param("address"))
+		response, err := getDelegationResponse(c.Request.Context(), stakingAPI, c.Param("address"))
 		if err != nil {
-			ginutils.ErrorResponse(c).Message(err.Error()).Render()
+			renderError(c, err)
 			return
 		}
 
@@ -162,14 +162,15 @@ func makeStakingDelegationsRoute(router gin.IRouter, api blockatlas.Platform) {
 	})
 }
 
-func getDelegationResponse(p blockatlas.StakeAPI, address string) (blockatlas.DelegationResponse, error) {
-	delegations, err := p.GetDelegations(address)
+func getDelegationResponse(ctx context.Context, api blockatlas.StakeAPI, address string) (blockatlas.DelegationResponse, error) {
+	p := blockatlas.StakeAPIContext(api)
+	delegations, err := p.GetDelegationsWithContext(ctx, address)
 	if err != nil {
 		return blockatlas.DelegationResponse{
 			StakingResponse: getStakingResponse(p),
 		}, errors.E("Unable to fetch delegations list", err)
 	}
-	balance, err := p.UndelegatedBalance(address)
+	balance, err := p.UndelegatedBalanceWithContext(ctx, address)
 	if err != nil {
 		return blockatlas.DelegationResponse{
 			StakingResponse: getStakingResponse(p),
@@ -0,0 +1,242 @@
+package api
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/trustwallet/blockatlas/coin"
+	"github.com/trustwallet/blockatlas/pkg/address"
+	"github.com/trustwallet/blockatlas/pkg/blockatlas"
+	"github.com/trustwallet/blockatlas/pkg/ginutils"
+	"github.com/trustwallet/blockatlas/pkg/logger"
+	"github.com/trustwallet/blockatlas/services/subscription"
+	"io"
+	"net/http"
+	"strconv"
+)
+
+type SubscriptionsResponse struct {
+	GUID          string                   `json:"guid"`
+	Subscriptions blockatlas.Subscriptions `json:"subscriptions"`
+}
+
+// SetupSubscriptionAPI registers the routes managing the observer subscriptions of a GUID,
+// the changes are applied to the storage directly instead of going through the subscriptions queue
+func SetupSubscriptionAPI(root gin.IRouter, storage subscription.XpubStorage, auth string) {
+	router := root.Group("/v1/subscriptions", ginutils.TokenAuthMiddleware(auth))
+	makeGetSubscriptionsRoute(router, storage)
+	makeAddSubscriptionsRoute(router, storage)
+	makeReplaceSubscriptionsRoute(router, storage)
+	makeDeleteSubscriptionsRoute(router, storage)
+}
+
+// @Summary Get the subscriptions of a GUID
+// @ID get_subscriptions
+// @Description Get the addresses the GUID is subscribed to by coin id
+// @Produce json
+// @Tags Subscriptions
+// @Param guid path string true "the subscriber GUID"
+// @Success 200 {object} api.SubscriptionsResponse
+// @Failure 401 {object} ginutils.ApiError
+// @Router /v1/subscriptions/{guid} [get]
+func makeGetSubscriptionsRoute(router gin.IRouter, storage subscription.XpubStorage) {
+	router.GET("/:guid", func(c *gin.Context) {
+		renderSubscriptions(c, storage, c.Param("guid"))
+	})
+}
+
+// @Summary Add subscriptions to a GUID
+// @ID add_subscriptions
+// @Description Subscribe the GUID to the addresses, the existing subscriptions are kept
+// @Accept json
+// @Produce json
+// @Tags Subscriptions
+// @Param guid path string true "the subscriber GUID"
+// @Param subscriptions body blockatlas.Subscriptions true "Addresses by coin id"
+// @Success 200 {object} api.SubscriptionsResponse
+// @Failure 400 {object} ginutils.ApiError
+// @Failure 401 {object} ginutils.ApiError
+// @Router /v1/subscriptions/{guid} [post]
+func makeAddSubscriptionsRoute(router gin.IRouter, storage subscription.XpubStorage) {
+	router.POST("/:guid", func(c *gin.Context) {
+		guid := c.Param("guid")
+		subs, ok := bindSubscriptions(c, guid)
+		if !ok {
+			return
+		}
+		if err := storage.AddSubscriptions(subs); err != nil {
+			renderSubscriptionsError(c, err, guid)
+			return
+		}
+		if err := subscription.SubscribeXpubs(storage, subs); err != nil {
+			renderSubscriptionsError(c, err, guid)
+			return
+		}
+		renderSubscriptions(c, storage, guid)
+	})
+}
+
+// @Summary Replace the subscriptions of a GUID
+// @ID replace_subscriptions
+// @Description Subscribe the GUID to exactly the given addresses, the other subscriptions are deleted
+// @Accept json
+// @Produce json
+// @Tags Subscriptions
+// @Param guid path string true "the subscriber GUID"
+// @Param subscriptions body blockatlas.Subscriptions true "Addresses by coin id"
+// @Success 200 {object} api.SubscriptionsResponse
+// @Failure 400 {object} ginutils.ApiError
+// @Failure 401 {object} ginutils.ApiError
+// @Router /v1/subscriptions/{guid} [put]
+func makeReplaceSubscriptionsRoute(router gin.IRouter, storage subscription.XpubStorage) {
+	router.PUT("/:guid", func(c *gin.Context) {
+		guid := c.Param("guid")
+		subs, ok := bindSubscriptions(c, guid)
+		if !ok {
+			return
+		}
+		current, err := storage.GetSubscriptions(guid)
+		if err != nil {
+			renderSubscriptionsError(c, err, guid)
+			return
+		}
+		// The kept subscriptions are not deleted, so they don't miss any event in between
+		kept := make(map[blockatlas.Subscription]bool, len(subs))
+		for _, sub := range subs {
+			kept[sub] = true
+			// along with the derived addresses of the kept xpubs
+			derived, err := storage.GetXpubAddresses(sub.Coin, sub.Address)
+			if err != nil {
+				renderSubscriptionsError(c, err, guid)
+				return
+			}
+			for _, address := range derived {
+				kept[blockatlas.Subscription{Coin: sub.Coin, Address: address, GUID: guid}] = true
+			}
+		}
+		removed := make([]blockatlas.Subscription, 0)
+		for _, sub := range current {
+			if !kept[sub] {
+				removed = append(removed, sub)
+			}
+		}
+		if err := subscription.UnsubscribeXpubs(storage, removed); err != nil {
+			renderSubscriptionsError(c, err, guid)
+			return
+		}
+		if err := storage.DeleteSubscriptions(removed); err != nil {
+			renderSubscriptionsError(c, err, guid)
+			return
+		}
+		if err := storage.AddSubscriptions(subs); err != nil {
+			renderSubscriptionsError(c, err, guid)
+			return
+		}
+		if err := subscription.SubscribeXpubs(storage, subs); err != nil {
+			renderSubscriptionsError(c, err, guid)
+			return
+		}
+		renderSubscriptions(c, storage, guid)
+	})
+}
+
+// @Summary Delete subscriptions of a GUID
+// @ID delete_subscriptions
+// @Description Unsubscribe the GUID from the given addresses, or from all of them without a body
+// @Accept json
+// @Produce json
+// @Tags Subscriptions
+// @Param guid path string true "the subscriber GUID"
+// @Param subscriptions body blockatlas.Subscriptions false "Addresses by coin id"
+// @Success 200 {object} api.SubscriptionsResponse
+// @Failure 400 {object} ginutils.ApiError
+// @Failure 401 {object} ginutils.ApiError
+// @Router /v1/subscriptions/{guid} [delete]
+func makeDeleteSubscriptionsRoute(router gin.IRouter, storage subscription.XpubStorage) {
+	router.DELETE("/:guid", func(c *gin.Context) {
+		guid := c.Param("guid")
+		var subs []blockatlas.Subscription
+		var req blockatlas.Subscriptions
+		err := c.ShouldBindJSON(&req)
+		switch {
+		case err == io.EOF:
+			subs, err = storage.GetSubscriptions(guid)
+			if err != nil {
+				renderSubscriptionsError(c, err, guid)
+				return
+			}
+		case err != nil:
+			ginutils.RenderError(c, http.StatusBadRequest, err.Error())
+			return
+		default:
+			var ok bool
+			subs, ok = parseSubscriptions(c, guid, req)
+			if !ok {
+				return
+			}
+		}
+		if err := subscription.UnsubscribeXpubs(storage, subs); err != nil {
+			renderSubscriptionsError(c, err, guid)
+			return
+		}
+		if err := storage.DeleteSubscriptions(subs); err != nil {
+			renderSubscriptionsError(c, err, guid)
+			return
+		}
+		renderSubscriptions(c, storage, guid)
+	})
+}
+
+func bindSubscriptions(c *gin.Context, guid string) ([]blockatlas.Subscription, bool) {
+	var req blockatlas.Subscriptions
+	if err := c.ShouldBindJSON(&req); err != nil {
+		ginutils.RenderError(c, http.StatusBadRequest, err.Error())
+		return nil, false
+	}
+	return parseSubscriptions(c, guid, req)
+}
+
+// parseSubscriptions converts the request to the subscriptions, the coins must be known
+// and the addresses valid, they are returned in their canonical form
+func parseSubscriptions(c *gin.Context, guid string, req blockatlas.Subscriptions) ([]blockatlas.Subscription, bool) {
+	for coinStr := range req {
+		id, err := strconv.ParseUint(coinStr, 10, 32)
+		if err != nil {
+			ginutils.RenderError(c, http.StatusBadRequest, "Invalid coin: "+coinStr)
+			return nil, false
+		}
+		if _, ok := coin.Coins[uint(id)]; !ok {
+			ginutils.RenderError(c, http.StatusBadRequest, "Unknown coin: "+coinStr)
+			return nil, false
+		}
+	}
+	event := blockatlas.SubscriptionEvent{GUID: guid}
+	subs := event.ParseSubscriptions(req)
+	for i, sub := range subs {
+		if !address.IsSupported(sub.Coin) || address.IsExtendedPubKey(sub.Address) {
+			continue
+		}
+		normalized, err := address.Normalize(sub.Coin, sub.Address)
+		if err != nil {
+			ginutils.RenderError(c, http.StatusBadRequest, "Invalid address: "+sub.Address)
+			return nil, false
+		}
+		subs[i].Address = normalized
+	}
+	return subs, true
+}
+
+func renderSubscriptions(c *gin.Context, storage subscription.XpubStorage, guid string) {
+	subs, err := storage.GetSubscriptions(guid)
+	if err != nil {
+		renderSubscriptionsError(c, err, guid)
+		return
+	}
+	ginutils.RenderSuccess(c, SubscriptionsResponse{
+		GUID:          guid,
+		Subscriptions: blockatlas.MakeSubscriptions(subs),
+	})
+}
+
+func renderSubscriptionsError(c *gin.Context, err error, guid string) {
+	logger.Error(err, "Subscriptions storage failed", logger.Params{"guid": guid})
+	ginutils.ErrorResponse(c).Render()
+}
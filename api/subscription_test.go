@@ -0,0 +1,129 @@
+package api
+
+import (
+	"encoding/json"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/trustwallet/blockatlas/pkg/blockatlas"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+type subscriptionStorage struct {
+	subs map[blockatlas.Subscription]bool
+}
+
+func (s *subscriptionStorage) Lookup(coin uint, addresses []string) ([]blockatlas.Subscription, error) {
+	return nil, nil
+}
+
+func (s *subscriptionStorage) AddSubscriptions(subscriptions []blockatlas.Subscription) error {
+	for _, sub := range subscriptions {
+		s.subs[sub] = true
+	}
+	return nil
+}
+
+func (s *subscriptionStorage) DeleteSubscriptions(subscriptions []blockatlas.Subscription) error {
+	for _, sub := range subscriptions {
+		delete(s.subs, sub)
+	}
+	return nil
+}
+
+func (s *subscriptionStorage) GetSubscriptions(guid string) ([]blockatlas.Subscription, error) {
+	result := make([]blockatlas.Subscription, 0)
+	for sub := range s.subs {
+		if sub.GUID == guid {
+			result = append(result, sub)
+		}
+	}
+	return result, nil
+}
+
+func (s *subscriptionStorage) GetXpubAddresses(coin uint, xpub string) ([]string, error) {
+	return nil, nil
+}
+
+func (s *subscriptionStorage) AddXpubAddresses(coin uint, xpub string, addresses []string) error {
+	return nil
+}
+
+func (s *subscriptionStorage) LookupXpubs(coin uint, addresses []string) (map[string]string, error) {
+	return map[string]string{}, nil
+}
+
+func serveSubscriptions(router *gin.Engine, method, path, body string) (int, SubscriptionsResponse) {
+	req := httptest.NewRequest(method, path, strings.NewReader(body))
+	req.Header.Set("Authorization", "Bearer token")
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+	var resp SubscriptionsResponse
+	_ = json.Unmarshal(w.Body.Bytes(), &resp)
+	return w.Code, resp
+}
+
+func TestSubscriptionAPI(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	storage := &subscriptionStorage{subs: make(map[blockatlas.Subscription]bool)}
+	SetupSubscriptionAPI(router, storage, "token")
+
+	code, resp := serveSubscriptions(router, http.MethodGet, "/v1/subscriptions/unknown", "")
+	assert.Equal(t, http.StatusOK, code)
+	assert.Equal(t, "unknown", resp.GUID)
+	assert.Empty(t, resp.Subscriptions)
+
+	code, resp = serveSubscriptions(router, http.MethodPost, "/v1/subscriptions/guid1",
+		`{"60":["0xfc10cab6a50a1ab10c56983c80cc82afc6559cf1"],"0":["1BvBMSEYstWetqTFn5Au4m4GFg7xJaNVN2"]}`)
+	assert.Equal(t, http.StatusOK, code)
+	assert.Equal(t, blockatlas.Subscriptions{
+		"60": {"0xfc10cAb6a50a1AB10C56983c80cc82afC6559Cf1"},
+		"0":  {"1BvBMSEYstWetqTFn5Au4m4GFg7xJaNVN2"},
+	}, resp.Subscriptions)
+
+	code, resp = serveSubscriptions(router, http.MethodDelete, "/v1/subscriptions/guid1",
+		`{"60":["0xfc10cab6a50a1ab10c56983c80cc82afc6559cf1"]}`)
+	assert.Equal(t, http.StatusOK, code)
+	assert.Equal(t, blockatlas.Subscriptions{"0": {"1BvBMSEYstWetqTFn5Au4m4GFg7xJaNVN2"}}, resp.Subscriptions)
+
+	// Without a body all the subscriptions of the GUID are deleted
+	code, resp = serveSubscriptions(router, http.MethodDelete, "/v1/subscriptions/guid1", "")
+	assert.Equal(t, http.StatusOK, code)
+	assert.Empty(t, resp.Subscriptions)
+	assert.Empty(t, storage.subs)
+}
+
+func TestSubscriptionAPI_invalid(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	storage := &subscriptionStorage{subs: make(map[blockatlas.Subscription]bool)}
+	SetupSubscriptionAPI(router, storage, "token")
+
+	tests := []struct {
+		name   string
+		method string
+		body   string
+	}{
+		{"invalid coin", http.MethodPost, `{"eth":["0xfc10cab6a50a1ab10c56983c80cc82afc6559cf1"]}`},
+		{"unknown coin", http.MethodPost, `{"123456":["0xfc10cab6a50a1ab10c56983c80cc82afc6559cf1"]}`},
+		{"invalid address", http.MethodPost, `{"60":["0xfc10cab6a50a1ab10c56983c80cc82afc6559c"]}`},
+		{"invalid json", http.MethodPost, `{"60":`},
+		{"replace invalid address", http.MethodPut, `{"0":["1BvBMSEYstWetqTFn5Au4m4GFg7xJaNVN3"]}`},
+		{"delete invalid coin", http.MethodDelete, `{"eth":["0xfc10cab6a50a1ab10c56983c80cc82afc6559cf1"]}`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			code, _ := serveSubscriptions(router, tt.method, "/v1/subscriptions/guid1", tt.body)
+			assert.Equal(t, http.StatusBadRequest, code)
+			assert.Empty(t, storage.subs)
+		})
+	}
+
+	req := httptest.NewRequest(http.MethodGet, "/v1/subscriptions/guid1", nil)
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+	assert.Equal(t, http.StatusUnauthorized, w.Code)
+}
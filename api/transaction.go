@@ -1,6 +1,7 @@
 package api
 
 import (
+	"errors"
 	"github.com/gin-gonic/gin"
 	"github.com/trustwallet/blockatlas/pkg/blockatlas"
 	"github.com/trustwallet/blockatlas/pkg/ginutils"
@@ -24,17 +25,111 @@ func makeTxRouteV1(router gin.IRouter, api blockatlas.Platform) {
 
 // @Summary Get Transactions
 // @ID tx_v2
-// @Description Get transactions from the address
+// @Description Get transactions from the address, newest first. Use next_cursor from the response to fetch older transactions.
 // @Accept json
 // @Produce json
 // @Tags Transactions
 // @Param coin path string true "the coin name" default(tezos)
 // @Param address path string true "the query address" default(tz1WCd2jm4uSt4vntk4vSuUWoZQGhLcDuR9q)
-// @Success 200 {object} blockatlas.TxPage
+// @Param token query string false "the token id"
+// @Param cursor query string false "the next_cursor value of the previous page"
+// @Success 200 {object} blockatlas.TxCursorPage
+// @Failure 400 {object} ginutils.ApiError
 // @Failure 500 {object} ginutils.ApiError
 // @Router /v2/{coin}/transactions/{address} [get]
 func makeTxRouteV2(router gin.IRouter, api blockatlas.Platform) {
-	makeTxRoute(router, api, "/transactions/:address")
+	var txAPI blockatlas.TxAPI
+	var tokenTxAPI blockatlas.TokenTxAPI
+	var txCursorAPI blockatlas.TxCursorAPI
+	var tokenTxCursorAPI blockatlas.TokenTxCursorAPI
+	txAPI, _ = api.(blockatlas.TxAPI)
+	tokenTxAPI, _ = api.(blockatlas.TokenTxAPI)
+	txCursorAPI, _ = api.(blockatlas.TxCursorAPI)
+	tokenTxCursorAPI, _ = api.(blockatlas.TokenTxCursorAPI)
+
+	if txAPI == nil && tokenTxAPI == nil && txCursorAPI == nil && tokenTxCursorAPI == nil {
+		return
+	}
+
+	router.GET("/transactions/:address", func(c *gin.Context) {
+		address := c.Param("address")
+		if address == "" {
+			emptyPage(c)
+			return
+		}
+		token := c.Query("token")
+
+		var cursor *blockatlas.TxCursor
+		if rawCursor := c.Query("cursor"); rawCursor != "" {
+			var err error
+			cursor, err = blockatlas.ParseTxCursor(rawCursor)
+			if err != nil {
+				ginutils.RenderError(c, http.StatusBadRequest, "Invalid cursor")
+				return
+			}
+		}
+
+		var page blockatlas.TxCursorPage
+		var txs blockatlas.TxPage
+		var err error
+		switch {
+		case token == "" && txCursorAPI != nil:
+			page, err = txCursorAPI.GetTxsByAddressFromCursor(c.Request.Context(), address, cursor)
+		case token == "" && txAPI != nil:
+			txs, err = blockatlas.TxAPIContext(txAPI).GetTxsByAddressWithContext(c.Request.Context(), address)
+			page = txs.Paginate(cursor, blockatlas.TxPerPage)
+		case token != "" && tokenTxCursorAPI != nil:
+			page, err = tokenTxCursorAPI.GetTokenTxsByAddressFromCursor(c.Request.Context(), address, token, cursor)
+		case token != "" && tokenTxAPI != nil:
+			txs, err = blockatlas.TokenTxAPIContext(tokenTxAPI).GetTokenTxsByAddressWithContext(c.Request.Context(), address, token)
+			page = txs.Paginate(cursor, blockatlas.TxPerPage)
+		default:
+			emptyPage(c)
+			return
+		}
+
+		if err != nil {
+			renderError(c, err)
+			return
+		}
+
+		page.Txs = setTxDirections(page.Txs, address)
+		ginutils.RenderSuccess(c, &page)
+	})
+}
+
+// @Summary Get Transaction
+// @ID tx_hash
+// @Description Get a single transaction by its hash, poll it to follow the status of a broadcasted transaction
+// @Accept json
+// @Produce json
+// @Tags Transactions
+// @Param coin path string true "the coin name" default(binance)
+// @Param hash path string true "the transaction hash" default(A45CC3E0B46D8ABAF6F1ECE1FC9E8D5A0C4B35F5F6D8D0A7CCBCF7D4A4B8E8E2)
+// @Success 200 {object} blockatlas.Tx
+// @Failure 404 {object} ginutils.ApiError
+// @Failure 500 {object} ginutils.ApiError
+// @Router /v2/{coin}/transaction/{hash} [get]
+func makeTxByHashRoute(router gin.IRouter, api blockatlas.Platform) {
+	var txByHashAPI blockatlas.TxByHashAPI
+	txByHashAPI, _ = api.(blockatlas.TxByHashAPI)
+
+	if txByHashAPI == nil {
+		return
+	}
+
+	router.GET("/transaction/:hash", func(c *gin.Context) {
+		tx, err := blockatlas.TxByHashAPIContext(txByHashAPI).GetTxByHashWithContext(c.Request.Context(), c.Param("hash"))
+		if errors.Is(err, blockatlas.ErrNotFound) {
+			ginutils.RenderError(c, http.StatusNotFound, "No such transaction")
+			return
+		}
+		if err != nil {
+			renderError(c, err)
+			return
+		}
+		ginutils.RenderSuccess(c, tx)
+	})
 }
 
 func makeTxRoute(router gin.IRouter, api blockatlas.Platform, path string) {
@@ -59,43 +154,20 @@ func makeTxRoute(router gin.IRouter, api blockatlas.Platform, path string) {
 		var err error
 		switch {
 		case token == "" && txAPI != nil:
-			txs, err = txAPI.GetTxsByAddress(address)
+			txs, err = blockatlas.TxAPIContext(txAPI).GetTxsByAddressWithContext(c.Request.Context(), address)
 		case token != "" && tokenTxAPI != nil:
-			txs, err = tokenTxAPI.GetTokenTxsByAddress(address, token)
+			txs, err = blockatlas.TokenTxAPIContext(tokenTxAPI).GetTokenTxsByAddressWithContext(c.Request.Context(), address, token)
 		default:
 			emptyPage(c)
 			return
 		}
 
 		if err != nil {
-			errResp := ginutils.ErrorResponse(c)
-			switch {
-			case err == blockatlas.ErrInvalidAddr:
-				errResp.Params(http.StatusBadRequest, "Invalid address")
-			case err == blockatlas.ErrNotFound:
-				errResp.Params(http.StatusNotFound, "No such address")
-			case err == blockatlas.ErrSourceConn:
-				errResp.Params(http.StatusServiceUnavailable, "Lost connection to blockchain")
-			}
-			errResp.Render()
+			renderError(c, err)
 			return
 		}
 
-		page := make(blockatlas.TxPage, 0)
-		for _, tx := range txs {
-			if tx.Direction != "" {
-				goto AddTx
-			}
-			tx.Direction = blockatlas.DirectionOutgoing
-			if tx.To == address {
-				tx.Direction = blockatlas.DirectionIncoming
-				if tx.From == address {
-					tx.Direction = blockatlas.DirectionSelf
-				}
-			}
-		AddTx:
-			page = append(page, tx)
-		}
+		page := setTxDirections(txs, address)
 		if len(page) > blockatlas.TxPerPage {
 			page = page[0:blockatlas.TxPerPage]
 		}
@@ -104,6 +176,25 @@ func makeTxRoute(router gin.IRouter, api blockatlas.Platform, path string) {
 	})
 }
 
+func setTxDirections(txs []blockatlas.Tx, address string) blockatlas.TxPage {
+	page := make(blockatlas.TxPage, 0)
+	for _, tx := range txs {
+		if tx.Direction != "" {
+			goto AddTx
+		}
+		tx.Direction = blockatlas.DirectionOutgoing
+		if tx.To == address {
+			tx.Direction = blockatlas.DirectionIncoming
+			if tx.From == address {
+				tx.Direction = blockatlas.DirectionSelf
+			}
+		}
+	AddTx:
+		page = append(page, tx)
+	}
+	return page
+}
+
 // @Summary Get Tokens
 // @ID tokens
 // @Description Get tokens from the address
@@ -130,9 +221,9 @@ func makeTokenRoute(router gin.IRouter, api blockatlas.Platform) {
 			return
 		}
 
-		tl, err := tokenAPI.GetTokenListByAddress(address)
+		tl, err := blockatlas.TokenAPIContext(tokenAPI).GetTokenListByAddressWithContext(c.Request.Context(), address)
 		if err != nil {
-			ginutils.ErrorResponse(c).Message(err.Error()).Render()
+			renderError(c, err)
 			return
 		}
 
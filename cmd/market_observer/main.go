@@ -0,0 +1,41 @@
+package main
+
+import (
+	"github.com/spf13/viper"
+	"github.com/trustwallet/blockatlas/internal"
+	"github.com/trustwallet/blockatlas/pkg/logger"
+	"github.com/trustwallet/blockatlas/services/markets"
+	"github.com/trustwallet/blockatlas/storage"
+	"time"
+)
+
+const (
+	defaultConfigPath = "../../config.yml"
+)
+
+var (
+	confPath string
+	cache    *storage.Storage
+	provider markets.Provider
+)
+
+func init() {
+	_, confPath := internal.ParseArgs("", defaultConfigPath)
+
+	internal.InitConfig(confPath)
+	logger.InitLogger()
+
+	redisHost := viper.GetString("storage.redis")
+	cache = internal.InitRedis(redisHost)
+	go storage.RestoreConnectionWorker(cache, redisHost, time.Second*10)
+
+	provider = internal.InitMarketProvider(viper.GetString("market.provider"), viper.GetString("market.api"))
+}
+
+func main() {
+	interval := viper.GetDuration("market.update_interval")
+	if interval <= 0 {
+		logger.Fatal("market update interval must be positive")
+	}
+	markets.RunWorker(provider, cache, interval)
+}
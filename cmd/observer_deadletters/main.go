@@ -0,0 +1,65 @@
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"github.com/spf13/viper"
+	"github.com/trustwallet/blockatlas/internal"
+	"github.com/trustwallet/blockatlas/observer"
+	"github.com/trustwallet/blockatlas/pkg/logger"
+	"github.com/trustwallet/blockatlas/storage"
+	"os"
+	"strings"
+)
+
+const (
+	defaultConfigPath = "../../config.yml"
+)
+
+var (
+	cache  storage.Backend
+	replay = flag.Bool("replay", false, "return the dead events to the outbox, the running observer_worker delivers them again")
+	ids    = flag.String("ids", "", "comma separated event ids to replay, all the dead events by default")
+)
+
+func init() {
+	_, confPath := internal.ParseArgs("", defaultConfigPath)
+
+	internal.InitConfig(confPath)
+	logger.InitLogger()
+
+	storageBackend := viper.GetString("storage.backend")
+	redisHost := viper.GetString("storage.redis")
+	postgresHost := viper.GetString("storage.postgres")
+
+	cache = internal.InitStorage(storageBackend, redisHost, postgresHost)
+}
+
+// Prints the dead letters of the observer outbox as JSON lines or replays them with -replay
+func main() {
+	if *replay {
+		var selected []string
+		if len(*ids) > 0 {
+			selected = strings.Split(*ids, ",")
+		}
+		count, err := observer.ReplayDeadLetters(cache, selected...)
+		if err != nil {
+			logger.Fatal(err)
+		}
+		logger.Info("Dead letters replayed", logger.Params{"count": count})
+		return
+	}
+
+	events, err := observer.DeadLetters(cache)
+	if err != nil {
+		logger.Fatal(err)
+	}
+	encoder := json.NewEncoder(os.Stdout)
+	for _, event := range events {
+		if err := encoder.Encode(event); err != nil {
+			logger.Fatal(err)
+		}
+	}
+	fmt.Fprintf(os.Stderr, "%d dead letters\n", len(events))
+}
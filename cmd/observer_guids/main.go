@@ -0,0 +1,36 @@
+package main
+
+import (
+	"github.com/spf13/viper"
+	"github.com/trustwallet/blockatlas/internal"
+	"github.com/trustwallet/blockatlas/pkg/logger"
+	"github.com/trustwallet/blockatlas/storage"
+)
+
+const (
+	defaultConfigPath = "../../config.yml"
+)
+
+var cache *storage.Storage
+
+func init() {
+	_, confPath := internal.ParseArgs("", defaultConfigPath)
+
+	internal.InitConfig(confPath)
+	logger.InitLogger()
+
+	// PostgreSQL indexes the subscriptions by GUID since its first migrations
+	if backend := viper.GetString("storage.backend"); backend != storage.BackendRedis && backend != "" {
+		logger.Fatal("The GUID index is only backfilled in Redis", logger.Params{"backend": backend})
+	}
+	cache = internal.InitRedis(viper.GetString("storage.redis"))
+}
+
+// Backfills the ATLAS_GUIDS index with the Redis subscriptions added before it existed, it can be run again safely
+func main() {
+	count, err := cache.BackfillGuids()
+	if err != nil {
+		logger.Fatal(err)
+	}
+	logger.Info("GUID index backfilled", logger.Params{"subscriptions": count})
+}
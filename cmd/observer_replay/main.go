@@ -0,0 +1,116 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"github.com/spf13/viper"
+	"github.com/trustwallet/blockatlas/internal"
+	"github.com/trustwallet/blockatlas/mq"
+	"github.com/trustwallet/blockatlas/observer"
+	"github.com/trustwallet/blockatlas/pkg/logger"
+	"github.com/trustwallet/blockatlas/platform"
+	"github.com/trustwallet/blockatlas/storage"
+	"net/http"
+	"os"
+)
+
+const (
+	defaultConfigPath = "../../config.yml"
+)
+
+var (
+	cache    storage.Backend
+	webhooks bool
+
+	coinHandle = flag.String("coin", "", "handle of the platform to replay, e.g. bitcoin")
+	from       = flag.Int64("from", 0, "first block height of the range")
+	to         = flag.Int64("to", 0, "last block height of the range")
+	conns      = flag.Int("conns", 8, "max connections to open to the platform API")
+	dryRun     = flag.Bool("dry-run", false, "print the matched events as JSON lines instead of dispatching them")
+)
+
+func init() {
+	_, confPath := internal.ParseArgs("", defaultConfigPath)
+
+	internal.InitConfig(confPath)
+	logger.InitLogger()
+
+	if *coinHandle == "" || *from <= 0 || *to < *from || *conns <= 0 {
+		logger.Fatal("Usage: observer_replay -coin <handle> -from <height> -to <height> [-conns 8] [-dry-run]")
+	}
+
+	storageBackend := viper.GetString("storage.backend")
+	redisHost := viper.GetString("storage.redis")
+	postgresHost := viper.GetString("storage.postgres")
+
+	cache = internal.InitStorage(storageBackend, redisHost, postgresHost)
+	platform.Init(*coinHandle)
+
+	if *dryRun {
+		return
+	}
+	switch delivery := viper.GetString("observer.delivery"); delivery {
+	case "", "rabbitmq":
+		internal.InitRabbitMQ(viper.GetString("observer.rabbitmq.uri"), 0)
+	case "webhook":
+		// The webhooks of the guids without their own secret are not delivered, they can't be verified
+		if len(viper.GetString("observer.webhook.secret")) == 0 {
+			logger.Warn("No default webhook secret, only the guids with a webhook_secret get the events")
+		}
+		webhooks = true
+	default:
+		logger.Fatal("Unknown observer delivery", logger.Params{"delivery": delivery})
+	}
+}
+
+// Re-scans a block range of a coin and dispatches the events of the subscribed addresses again.
+// The live tracker height of observer_worker is not changed
+func main() {
+	api, ok := platform.BlockAPIs[*coinHandle]
+	if !ok {
+		logger.Fatal("Platform has no block API", logger.Params{"coin": *coinHandle})
+	}
+
+	stream := observer.Stream{BlockAPI: api}
+	blocks := stream.Replay(context.Background(), *from, *to, *conns)
+
+	obs := observer.Observer{
+		Storage: cache,
+		Coin:    api.Coin().ID,
+	}
+	events := obs.Execute(blocks)
+
+	if *dryRun {
+		encoder := json.NewEncoder(os.Stdout)
+		var count int
+		for event := range events {
+			if err := encoder.Encode(observer.NewDispatchEvent(event)); err != nil {
+				logger.Fatal(err)
+			}
+			count++
+		}
+		logger.Info("Dry run finished", logger.Params{"coin": *coinHandle, "from": *from, "to": *to, "events": count})
+		return
+	}
+
+	if !webhooks {
+		defer mq.Close()
+		if err := mq.Transactions.Declare(); err != nil {
+			logger.Fatal(err)
+		}
+	}
+	dispatcher := &observer.Dispatcher{
+		Outbox:      cache,
+		MaxAttempts: viper.GetInt("observer.outbox.max_attempts"),
+	}
+	if webhooks {
+		dispatcher.Client = http.Client{Timeout: viper.GetDuration("observer.webhook.timeout")}
+		dispatcher.Webhooks = cache
+		dispatcher.Secret = viper.GetString("observer.webhook.secret")
+		dispatcher.MaxElapsedTime = viper.GetDuration("observer.webhook.max_elapsed_time")
+	}
+	dispatcher.Run(events)
+	dispatcher.Wait()
+	logger.Info("Replay finished", logger.Params{"coin": *coinHandle, "from": *from, "to": *to})
+}
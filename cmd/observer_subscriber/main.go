@@ -6,6 +6,7 @@ import (
 	"github.com/trustwallet/blockatlas/internal"
 	"github.com/trustwallet/blockatlas/mq"
 	"github.com/trustwallet/blockatlas/pkg/logger"
+	"github.com/trustwallet/blockatlas/platform"
 	"github.com/trustwallet/blockatlas/services/subscription"
 	"github.com/trustwallet/blockatlas/storage"
 	"time"
@@ -17,7 +18,7 @@ const (
 
 var (
 	confPath string
-	cache    *storage.Storage
+	cache    storage.Backend
 )
 
 func init() {
@@ -26,21 +27,28 @@ func init() {
 	internal.InitConfig(confPath)
 	logger.InitLogger()
 
+	storageBackend := viper.GetString("storage.backend")
 	redisHost := viper.GetString("storage.redis")
+	postgresHost := viper.GetString("storage.postgres")
 	mqHost := viper.GetString("observer.rabbitmq.uri")
 	prefetchCount := viper.GetInt("observer.rabbitmq.consumer.prefetch_count")
 
-	cache = internal.InitRedis(redisHost)
+	cache = internal.InitStorage(storageBackend, redisHost, postgresHost)
+	// The platforms derive the addresses of the subscribed xpubs
+	platform.Init(viper.GetString("platform"))
 
 	internal.InitRabbitMQ(mqHost, prefetchCount)
+	internal.InitMetrics(viper.GetString("metrics.subscriber"))
 	
 	go mq.FatalWorker(time.Second * 10)
-	go storage.RestoreConnectionWorker(cache, redisHost, time.Second * 10)
 }
 
 func main() {
 	defer mq.Close()
-	if err := mq.Subscriptions.Declare(); err != nil{
+	if err := mq.Subscriptions.Declare(); err != nil {
+		logger.Fatal(err)
+	}
+	if err := mq.SubscriptionsDead.Declare(); err != nil {
 		logger.Fatal(err)
 	}
 	mq.Subscriptions.RunConsumer(subscription.Consume, cache)
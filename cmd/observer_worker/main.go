@@ -1,7 +1,6 @@
 package main
 
 import (
-	"context"
 	"github.com/spf13/viper"
 	"github.com/trustwallet/blockatlas/internal"
 	"github.com/trustwallet/blockatlas/mq"
@@ -9,6 +8,7 @@ import (
 	"github.com/trustwallet/blockatlas/pkg/logger"
 	"github.com/trustwallet/blockatlas/platform"
 	"github.com/trustwallet/blockatlas/storage"
+	"net/http"
 	"sync"
 	"time"
 )
@@ -19,7 +19,8 @@ const (
 
 var (
 	confPath string
-	cache    *storage.Storage
+	cache    storage.Backend
+	webhooks bool
 )
 
 func init() {
@@ -28,23 +29,38 @@ func init() {
 	internal.InitConfig(confPath)
 	logger.InitLogger()
 
+	storageBackend := viper.GetString("storage.backend")
 	redisHost := viper.GetString("storage.redis")
+	postgresHost := viper.GetString("storage.postgres")
 	mqHost := viper.GetString("observer.rabbitmq.uri")
 	prefetchCount := viper.GetInt("observer.rabbitmq.consumer.prefetch_count")
 	platformHandle := viper.GetString("platform")
 
-	cache = internal.InitRedis(redisHost)
-	internal.InitRabbitMQ(mqHost, prefetchCount)
+	cache = internal.InitStorage(storageBackend, redisHost, postgresHost)
 	platform.Init(platformHandle)
-
-	go mq.RestoreConnectionWorker(mqHost,  mq.Transactions, time.Second * 10)
-	go storage.RestoreConnectionWorker(cache, redisHost, time.Second * 10)
+	internal.InitMetrics(viper.GetString("metrics.observer"))
+
+	switch delivery := viper.GetString("observer.delivery"); delivery {
+	case "", "rabbitmq":
+		internal.InitRabbitMQ(mqHost, prefetchCount)
+		go mq.RestoreConnectionWorker(mqHost, mq.Transactions, time.Second*10)
+	case "webhook":
+		// The webhooks of the guids without their own secret are not delivered, they can't be verified
+		if len(viper.GetString("observer.webhook.secret")) == 0 {
+			logger.Warn("No default webhook secret, only the guids with a webhook_secret get the events")
+		}
+		webhooks = true
+	default:
+		logger.Fatal("Unknown observer delivery", logger.Params{"delivery": delivery})
+	}
 }
 
 func main() {
-	defer mq.Close()
-	if err := mq.Transactions.Declare(); err != nil{
-		logger.Fatal(err)
+	if !webhooks {
+		defer mq.Close()
+		if err := mq.Transactions.Declare(); err != nil {
+			logger.Fatal(err)
+		}
 	}
 
 	if len(platform.BlockAPIs) == 0 {
@@ -52,17 +68,34 @@ func main() {
 	}
 
 	backlogTime := viper.GetDuration("observer.backlog")
+	reorgWindow := viper.GetInt("observer.reorg_window")
 	minInterval := viper.GetDuration("observer.block_poll.min")
 	maxInterval := viper.GetDuration("observer.block_poll.max")
+	mempoolEnabled := viper.GetBool("observer.mempool.enabled")
+	mempoolInterval := viper.GetDuration("observer.mempool.poll")
 
 	if minInterval >= maxInterval {
 		logger.Fatal("minimum block polling interval cannot be greater or equal than maximum")
 	}
 
+	// The events of all the coins go through the same outbox
+	dispatcher := &observer.Dispatcher{
+		Outbox:      cache,
+		MaxAttempts: viper.GetInt("observer.outbox.max_attempts"),
+	}
+	if webhooks {
+		dispatcher.Client = http.Client{Timeout: viper.GetDuration("observer.webhook.timeout")}
+		dispatcher.Webhooks = cache
+		dispatcher.Secret = viper.GetString("observer.webhook.secret")
+		dispatcher.MaxElapsedTime = viper.GetDuration("observer.webhook.max_elapsed_time")
+	}
+	ctx := internal.ShutdownContext()
+	go dispatcher.RunOutbox(ctx, viper.GetDuration("observer.outbox.retry_interval"))
+
 	var wg sync.WaitGroup
 	wg.Add(len(platform.BlockAPIs))
 
-	for _, api := range platform.BlockAPIs {
+	for handle, api := range platform.BlockAPIs {
 		coin := api.Coin()
 		pollInterval := observer.GetInterval(coin.BlockTime, minInterval, maxInterval)
 
@@ -80,18 +113,33 @@ func main() {
 			Tracker:      cache,
 			PollInterval: pollInterval,
 			BacklogCount: backlogCount,
+			ReorgWindow:  reorgWindow,
+		}
+		blocks := stream.Execute(ctx)
+
+		// Stream new mempool transactions along with the blocks
+		mempoolAPI, pending := platform.MempoolAPIs[handle]
+		pending = pending && mempoolEnabled
+		if pending {
+			mempool := observer.MempoolStream{
+				MempoolAPI:   mempoolAPI,
+				PollInterval: mempoolInterval,
+			}
+			blocks = observer.Merge(blocks, mempool.Execute(ctx))
 		}
-		blocks := stream.Execute(context.Background())
 
 		// Check for transaction events
 		obs := observer.Observer{
 			Storage: cache,
 			Coin:    coin.ID,
+			Pending: pending,
+		}
+		if addressAPI, ok := platform.AddressAPIs[coin.ID]; ok {
+			obs.Xpubs = &observer.XpubExtender{API: addressAPI, Storage: cache}
 		}
 		events := obs.Execute(blocks)
 
 		// Dispatch events
-		var dispatcher observer.Dispatcher
 		go func() {
 			dispatcher.Run(events)
 			wg.Done()
@@ -101,6 +149,7 @@ func main() {
 			"coin":     coin,
 			"interval": pollInterval,
 			"backlog":  backlogCount,
+			"pending":  pending,
 		})
 	}
 
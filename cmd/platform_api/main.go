@@ -1,14 +1,19 @@
 package main
 
 import (
+	"github.com/chenjiandongx/ginprom"
 	sentrygin "github.com/getsentry/sentry-go/gin"
 	"github.com/gin-gonic/gin"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
 	"github.com/spf13/viper"
 	"github.com/trustwallet/blockatlas/api"
 	_ "github.com/trustwallet/blockatlas/docs"
 	"github.com/trustwallet/blockatlas/internal"
+	"github.com/trustwallet/blockatlas/pkg/ginutils"
 	"github.com/trustwallet/blockatlas/pkg/logger"
 	"github.com/trustwallet/blockatlas/platform"
+	"github.com/trustwallet/blockatlas/storage"
+	"time"
 )
 
 const (
@@ -31,10 +36,28 @@ func init() {
 
 	engine = internal.InitEngine(sg, viper.GetString("gin.mode"))
 
+	internal.InitCache(viper.GetString("cache.backend"), viper.GetString("storage.redis"))
 	platform.Init(viper.GetString("platform"))
 }
 
 func main() {
+	engine.Use(ginutils.MetricsMiddleware())
+	engine.GET("/metrics", ginprom.PromHandler(promhttp.Handler()))
 	api.SetupPlatformAPI(engine)
+	if viper.GetBool("market.enabled") {
+		provider := internal.InitMarketProvider(viper.GetString("market.provider"), viper.GetString("market.api"))
+		redisHost := viper.GetString("storage.redis")
+		cache := internal.InitRedis(redisHost)
+		go storage.RestoreConnectionWorker(cache, redisHost, time.Second*10)
+		api.SetupMarketAPI(engine, provider, cache, viper.GetString("market.auth"))
+	}
+	if viper.GetBool("observer.subscriptions_api") {
+		auth := viper.GetString("observer.auth")
+		if auth == "" {
+			logger.Fatal("observer.auth is required by the subscriptions API")
+		}
+		backend := internal.InitStorage(viper.GetString("storage.backend"), viper.GetString("storage.redis"), viper.GetString("storage.postgres"))
+		api.SetupSubscriptionAPI(engine, backend, auth)
+	}
 	internal.SetupGracefulShutdown(port, engine)
 }
@@ -1,6 +1,6 @@
 // GENERATED BY THE COMMAND ABOVE; DO NOT EDIT
 // This file was generated by swaggo/swag at
-// 2020-03-01 04:39:26.356879 +0300 MSK m=+1.896562991
+// 2026-10-18 13:45:51.493641645 +0000 UTC m=+0.165094338
 
 package docs
 
@@ -69,51 +69,40 @@ var doc = `{
         },
         "/v1/market/charts": {
             "get": {
-                "description": "Get the charts data from an market and coin/token",
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "Get the price history since time_start converted to the currency",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
                     "Market"
                 ],
-                "summary": "Get charts data for a specific coin",
-                "operationId": "get_charts_data",
+                "summary": "Get the price history of a coin or token",
+                "operationId": "charts",
                 "parameters": [
                     {
                         "type": "integer",
                         "default": 60,
-                        "description": "Coin ID",
+                        "description": "Coin id",
                         "name": "coin",
                         "in": "query",
                         "required": true
                     },
                     {
                         "type": "string",
-                        "description": "Token ID",
+                        "description": "Token id",
                         "name": "token",
                         "in": "query"
                     },
                     {
                         "type": "integer",
-                        "default": 1574483028,
-                        "description": "Start timestamp",
+                        "description": "Start of the period as a unix timestamp, one day ago by default",
                         "name": "time_start",
                         "in": "query"
                     },
-                    {
-                        "type": "integer",
-                        "default": 64,
-                        "description": "Max number of items in result prices array",
-                        "name": "max_items",
-                        "in": "query"
-                    },
                     {
                         "type": "string",
                         "default": "USD",
-                        "description": "The currency to show charts",
+                        "description": "The currency of the prices",
                         "name": "currency",
                         "in": "query"
                     }
@@ -122,7 +111,13 @@ var doc = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/watchmarket.ChartData"
+                            "$ref": "#/definitions/blockatlas.ChartData"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/ginutils.ApiError"
                         }
                     }
                 }
@@ -130,44 +125,34 @@ var doc = `{
         },
         "/v1/market/info": {
             "get": {
-                "description": "Get the charts coin info data from an market and coin/contract",
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "Get the market capitalization, volume and supply converted to the currency",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
                     "Market"
                 ],
-                "summary": "Get charts coin info data for a specific coin",
-                "operationId": "get_charts_coin_info",
+                "summary": "Get the market info of a coin or token",
+                "operationId": "coin_info",
                 "parameters": [
                     {
                         "type": "integer",
                         "default": 60,
-                        "description": "Coin ID",
+                        "description": "Coin id",
                         "name": "coin",
                         "in": "query",
                         "required": true
                     },
                     {
                         "type": "string",
-                        "description": "Token ID",
+                        "description": "Token id",
                         "name": "token",
                         "in": "query"
                     },
-                    {
-                        "type": "integer",
-                        "default": 1574483028,
-                        "description": "Start timestamp",
-                        "name": "time_start",
-                        "in": "query"
-                    },
                     {
                         "type": "string",
                         "default": "USD",
-                        "description": "The currency to show coin info in",
+                        "description": "The currency of the values",
                         "name": "currency",
                         "in": "query"
                     }
@@ -176,7 +161,13 @@ var doc = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/watchmarket.ChartCoinInfo"
+                            "$ref": "#/definitions/blockatlas.ChartCoinInfo"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/ginutils.ApiError"
                         }
                     }
                 }
@@ -184,7 +175,7 @@ var doc = `{
         },
         "/v1/market/ticker": {
             "post": {
-                "description": "Get the ticker values from many market and coin/token",
+                "description": "Get the ticker values converted to the currency",
                 "consumes": [
                     "application/json"
                 ],
@@ -194,11 +185,11 @@ var doc = `{
                 "tags": [
                     "Market"
                 ],
-                "summary": "Get ticker values for a specific market",
-                "operationId": "get_tickers",
+                "summary": "Get ticker values for the list of coins and tokens",
+                "operationId": "ticker",
                 "parameters": [
                     {
-                        "description": "Ticker",
+                        "description": "Currency and the list of assets",
                         "name": "tickers",
                         "in": "body",
                         "required": true,
@@ -211,7 +202,204 @@ var doc = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/api.Tickers"
+                            "$ref": "#/definitions/blockatlas.TickerResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/ginutils.ApiError"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/subscriptions/{guid}": {
+            "get": {
+                "description": "Get the addresses the GUID is subscribed to by coin id",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Subscriptions"
+                ],
+                "summary": "Get the subscriptions of a GUID",
+                "operationId": "get_subscriptions",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "the subscriber GUID",
+                        "name": "guid",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/api.SubscriptionsResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/ginutils.ApiError"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "description": "Subscribe the GUID to exactly the given addresses, the other subscriptions are deleted",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Subscriptions"
+                ],
+                "summary": "Replace the subscriptions of a GUID",
+                "operationId": "replace_subscriptions",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "the subscriber GUID",
+                        "name": "guid",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Addresses by coin id",
+                        "name": "subscriptions",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/blockatlas.Subscriptions"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/api.SubscriptionsResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/ginutils.ApiError"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/ginutils.ApiError"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Subscribe the GUID to the addresses, the existing subscriptions are kept",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Subscriptions"
+                ],
+                "summary": "Add subscriptions to a GUID",
+                "operationId": "add_subscriptions",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "the subscriber GUID",
+                        "name": "guid",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Addresses by coin id",
+                        "name": "subscriptions",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/blockatlas.Subscriptions"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/api.SubscriptionsResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/ginutils.ApiError"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/ginutils.ApiError"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Unsubscribe the GUID from the given addresses, or from all of them without a body",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Subscriptions"
+                ],
+                "summary": "Delete subscriptions of a GUID",
+                "operationId": "delete_subscriptions",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "the subscriber GUID",
+                        "name": "guid",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Addresses by coin id",
+                        "name": "subscriptions",
+                        "in": "body",
+                        "schema": {
+                            "$ref": "#/definitions/blockatlas.Subscriptions"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/api.SubscriptionsResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/ginutils.ApiError"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/ginutils.ApiError"
                         }
                     }
                 }
@@ -301,6 +489,41 @@ var doc = `{
                 }
             }
         },
+        "/v2/balances": {
+            "post": {
+                "description": "Get the native and the token balances for multiple addresses and coins",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Balance"
+                ],
+                "summary": "Get Multiple Balances",
+                "operationId": "batch_balances",
+                "parameters": [
+                    {
+                        "description": "Addresses and coins",
+                        "name": "balances",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/api.AddressesRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/blockatlas.BalancePage"
+                        }
+                    }
+                }
+            }
+        },
         "/v2/collectibles/categories": {
             "post": {
                 "description": "Get collection categories",
@@ -452,20 +675,17 @@ var doc = `{
                 }
             }
         },
-        "/v2/{coin}/collections/{address}": {
+        "/v2/{coin}/address/{address}/validate": {
             "get": {
-                "description": "Get all collections from the address",
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "Check the address format of the coin and get the canonical form of the address",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Collections"
+                    "Address"
                 ],
-                "summary": "Get Collections",
-                "operationId": "collections_v2",
+                "summary": "Validate an address",
+                "operationId": "validate_address",
                 "parameters": [
                     {
                         "type": "string",
@@ -477,8 +697,8 @@ var doc = `{
                     },
                     {
                         "type": "string",
-                        "default": "0x5574Cd97432cEd0D7Caf58ac3c4fEDB2061C98fB",
-                        "description": "the query address",
+                        "default": "0xfc10cab6a50a1ab10c56983c80cc82afc6559cf1",
+                        "description": "the address",
                         "name": "address",
                         "in": "path",
                         "required": true
@@ -488,21 +708,15 @@ var doc = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/blockatlas.CollectionPage"
-                        }
-                    },
-                    "500": {
-                        "description": "Internal Server Error",
-                        "schema": {
-                            "$ref": "#/definitions/ginutils.ApiError"
+                            "$ref": "#/definitions/api.AddressValidation"
                         }
                     }
                 }
             }
         },
-        "/v2/{coin}/collections/{owner}/collection/{collection_id}": {
+        "/v2/{coin}/balance/{address}": {
             "get": {
-                "description": "Get a collection from the address",
+                "description": "Get the native and the token balances of the address",
                 "consumes": [
                     "application/json"
                 ],
@@ -510,14 +724,14 @@ var doc = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Collections"
+                    "Balance"
                 ],
-                "summary": "Get Collection",
-                "operationId": "collection_v2",
+                "summary": "Get Balance",
+                "operationId": "balance",
                 "parameters": [
                     {
                         "type": "string",
-                        "default": "ethereum",
+                        "default": "tron",
                         "description": "the coin name",
                         "name": "coin",
                         "in": "path",
@@ -525,26 +739,24 @@ var doc = `{
                     },
                     {
                         "type": "string",
-                        "default": "0x0875BCab22dE3d02402bc38aEe4104e1239374a7",
+                        "default": "TMuA6YqfCeX8EhbfYEg5y7S4DqzSJireY9",
                         "description": "the query address",
-                        "name": "owner",
+                        "name": "address",
                         "in": "path",
                         "required": true
                     },
                     {
                         "type": "string",
-                        "default": "0x06012c8cf97bead5deae237070f9587f8e7a266d",
-                        "description": "the query collection",
-                        "name": "collection_id",
-                        "in": "path",
-                        "required": true
+                        "description": "the token id, only the balance of this token is returned",
+                        "name": "token",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/blockatlas.CollectionPage"
+                            "$ref": "#/definitions/blockatlas.Balance"
                         }
                     },
                     "500": {
@@ -556,9 +768,9 @@ var doc = `{
                 }
             }
         },
-        "/v2/{coin}/staking/delegations/{address}": {
+        "/v2/{coin}/collections/{address}": {
             "get": {
-                "description": "Get stake delegations from the address",
+                "description": "Get all collections from the address",
                 "consumes": [
                     "application/json"
                 ],
@@ -566,14 +778,14 @@ var doc = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Staking"
+                    "Collections"
                 ],
-                "summary": "Get Stake Delegations",
-                "operationId": "delegations",
+                "summary": "Get Collections",
+                "operationId": "collections_v2",
                 "parameters": [
                     {
                         "type": "string",
-                        "default": "tron",
+                        "default": "ethereum",
                         "description": "the coin name",
                         "name": "coin",
                         "in": "path",
@@ -581,7 +793,7 @@ var doc = `{
                     },
                     {
                         "type": "string",
-                        "default": "TPJYCz8ppZNyvw7pTwmjajcx4Kk1MmEUhD",
+                        "default": "0x5574Cd97432cEd0D7Caf58ac3c4fEDB2061C98fB",
                         "description": "the query address",
                         "name": "address",
                         "in": "path",
@@ -592,7 +804,7 @@ var doc = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/blockatlas.DelegationResponse"
+                            "$ref": "#/definitions/blockatlas.CollectionPage"
                         }
                     },
                     "500": {
@@ -604,9 +816,9 @@ var doc = `{
                 }
             }
         },
-        "/v2/{coin}/staking/validators": {
+        "/v2/{coin}/collections/{owner}/collection/{collection_id}": {
             "get": {
-                "description": "Get validators from the address",
+                "description": "Get a collection from the address",
                 "consumes": [
                     "application/json"
                 ],
@@ -614,23 +826,197 @@ var doc = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Staking"
+                    "Collections"
                 ],
-                "summary": "Get Validators",
-                "operationId": "validators",
+                "summary": "Get Collection",
+                "operationId": "collection_v2",
                 "parameters": [
                     {
                         "type": "string",
-                        "default": "cosmos",
+                        "default": "ethereum",
                         "description": "the coin name",
                         "name": "coin",
                         "in": "path",
                         "required": true
-                    }
-                ],
-                "responses": {
-                    "200": {
-                        "description": "OK",
+                    },
+                    {
+                        "type": "string",
+                        "default": "0x0875BCab22dE3d02402bc38aEe4104e1239374a7",
+                        "description": "the query address",
+                        "name": "owner",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "default": "0x06012c8cf97bead5deae237070f9587f8e7a266d",
+                        "description": "the query collection",
+                        "name": "collection_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/blockatlas.CollectionPage"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/ginutils.ApiError"
+                        }
+                    }
+                }
+            }
+        },
+        "/v2/{coin}/fee": {
+            "get": {
+                "description": "Get the fast, normal and slow fee estimates in the smallest unit of the coin.\nThe transaction params are optional, they are used to estimate the gas limit.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Transactions"
+                ],
+                "summary": "Get Fee Estimates",
+                "operationId": "fee",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "default": "bitcoin",
+                        "description": "the coin name",
+                        "name": "coin",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "the sender address",
+                        "name": "from",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "the recipient or contract address",
+                        "name": "to",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "the amount in the smallest unit",
+                        "name": "value",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "the hex encoded call data",
+                        "name": "data",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/blockatlas.Fee"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/ginutils.ApiError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/ginutils.ApiError"
+                        }
+                    }
+                }
+            }
+        },
+        "/v2/{coin}/staking/delegations/{address}": {
+            "get": {
+                "description": "Get stake delegations from the address",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Staking"
+                ],
+                "summary": "Get Stake Delegations",
+                "operationId": "delegations",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "default": "tron",
+                        "description": "the coin name",
+                        "name": "coin",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "default": "TPJYCz8ppZNyvw7pTwmjajcx4Kk1MmEUhD",
+                        "description": "the query address",
+                        "name": "address",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/blockatlas.DelegationResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/ginutils.ApiError"
+                        }
+                    }
+                }
+            }
+        },
+        "/v2/{coin}/staking/validators": {
+            "get": {
+                "description": "Get validators from the address",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Staking"
+                ],
+                "summary": "Get Validators",
+                "operationId": "validators",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "default": "cosmos",
+                        "description": "the coin name",
+                        "name": "coin",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
                         "schema": {
                             "$ref": "#/definitions/blockatlas.DocsResponse"
                         }
@@ -692,9 +1078,118 @@ var doc = `{
                 }
             }
         },
+        "/v2/{coin}/transaction/{hash}": {
+            "get": {
+                "description": "Get a single transaction by its hash, poll it to follow the status of a broadcasted transaction",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Transactions"
+                ],
+                "summary": "Get Transaction",
+                "operationId": "tx_hash",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "default": "binance",
+                        "description": "the coin name",
+                        "name": "coin",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "default": "A45CC3E0B46D8ABAF6F1ECE1FC9E8D5A0C4B35F5F6D8D0A7CCBCF7D4A4B8E8E2",
+                        "description": "the transaction hash",
+                        "name": "hash",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/blockatlas.Tx"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/ginutils.ApiError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/ginutils.ApiError"
+                        }
+                    }
+                }
+            }
+        },
+        "/v2/{coin}/transactions/broadcast": {
+            "post": {
+                "description": "Broadcast a signed transaction",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Transactions"
+                ],
+                "summary": "Broadcast Transaction",
+                "operationId": "broadcast",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "default": "cosmos",
+                        "description": "the coin name",
+                        "name": "coin",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "The signed transaction in the chain encoding",
+                        "name": "transaction",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/api.BroadcastRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/blockatlas.BroadcastResult"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/ginutils.ApiError"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/ginutils.ApiError"
+                        }
+                    }
+                }
+            }
+        },
         "/v2/{coin}/transactions/{address}": {
             "get": {
-                "description": "Get transactions from the address",
+                "description": "Get transactions from the address, newest first. Use next_cursor from the response to fetch older transactions.",
                 "consumes": [
                     "application/json"
                 ],
@@ -722,13 +1217,31 @@ var doc = `{
                         "name": "address",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "the token id",
+                        "name": "token",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "the next_cursor value of the previous page",
+                        "name": "cursor",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/blockatlas.TxPage"
+                            "$ref": "#/definitions/blockatlas.TxCursorPage"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/ginutils.ApiError"
                         }
                     },
                     "500": {
@@ -985,90 +1498,179 @@ var doc = `{
                 }
             }
         },
-        "api.AddressesRequest": {
+        "api.AddressValidation": {
+            "type": "object",
+            "properties": {
+                "address": {
+                    "description": "Address is the canonical form of a valid address",
+                    "type": "string"
+                },
+                "valid": {
+                    "type": "boolean"
+                }
+            }
+        },
+        "api.AddressesRequest": {
+            "type": "array",
+            "items": {
+                "$ref": "#/definitions/api.AddressBatchRequest"
+            }
+        },
+        "api.BroadcastRequest": {
+            "type": "object",
+            "required": [
+                "raw"
+            ],
+            "properties": {
+                "raw": {
+                    "type": "string"
+                }
+            }
+        },
+        "api.SubscriptionsResponse": {
+            "type": "object",
+            "properties": {
+                "guid": {
+                    "type": "string"
+                },
+                "subscriptions": {
+                    "type": "object",
+                    "$ref": "#/definitions/blockatlas.Subscriptions"
+                }
+            }
+        },
+        "api.TickerRequest": {
+            "type": "object",
+            "properties": {
+                "assets": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/markets.Asset"
+                    }
+                },
+                "currency": {
+                    "type": "string"
+                }
+            }
+        },
+        "blockatlas.Balance": {
+            "type": "object",
+            "properties": {
+                "address": {
+                    "type": "string"
+                },
+                "balance": {
+                    "type": "string"
+                },
+                "coin": {
+                    "type": "integer"
+                },
+                "tokens": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/blockatlas.TokenBalance"
+                    }
+                }
+            }
+        },
+        "blockatlas.BalancePage": {
             "type": "array",
             "items": {
-                "$ref": "#/definitions/api.AddressBatchRequest"
+                "$ref": "#/definitions/blockatlas.Balance"
             }
         },
-        "api.Coin": {
+        "blockatlas.BroadcastResult": {
             "type": "object",
             "properties": {
-                "coin": {
-                    "type": "integer"
-                },
-                "token_id": {
-                    "type": "string"
-                },
-                "type": {
+                "id": {
                     "type": "string"
                 }
             }
         },
-        "api.Ticker": {
+        "blockatlas.ChartCoinInfo": {
             "type": "object",
             "properties": {
-                "coin": {
-                    "type": "integer"
-                },
-                "coin_name": {
-                    "type": "string"
-                },
-                "error": {
-                    "type": "string"
-                },
-                "last_update": {
-                    "type": "string"
+                "circulating_supply": {
+                    "type": "number"
                 },
-                "price": {
+                "info": {
                     "type": "object",
-                    "$ref": "#/definitions/api.TickerPrice"
+                    "$ref": "#/definitions/blockatlas.CoinInfo"
                 },
-                "token_id": {
-                    "type": "string"
+                "market_cap": {
+                    "type": "number"
                 },
-                "type": {
-                    "type": "string"
+                "total_supply": {
+                    "type": "number"
+                },
+                "volume_24": {
+                    "type": "number"
                 }
             }
         },
-        "api.TickerPrice": {
+        "blockatlas.ChartData": {
             "type": "object",
             "properties": {
-                "change_24h": {
-                    "type": "number"
-                },
-                "currency": {
+                "error": {
                     "type": "string"
                 },
-                "provider": {
-                    "type": "string"
+                "prices": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/blockatlas.ChartPrice"
+                    }
+                }
+            }
+        },
+        "blockatlas.ChartPrice": {
+            "type": "object",
+            "properties": {
+                "date": {
+                    "type": "integer"
                 },
-                "value": {
+                "price": {
                     "type": "number"
                 }
             }
         },
-        "api.TickerRequest": {
+        "blockatlas.CoinInfo": {
             "type": "object",
             "properties": {
-                "assets": {
+                "data_source": {
+                    "type": "string"
+                },
+                "description": {
+                    "type": "string"
+                },
+                "explorers": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/api.Coin"
+                        "$ref": "#/definitions/blockatlas.Link"
                     }
                 },
-                "currency": {
+                "name": {
+                    "type": "string"
+                },
+                "short_description": {
+                    "type": "string"
+                },
+                "socials": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/blockatlas.SocialLink"
+                    }
+                },
+                "source_code": {
+                    "type": "string"
+                },
+                "website": {
+                    "type": "string"
+                },
+                "white_paper": {
                     "type": "string"
                 }
             }
         },
-        "api.Tickers": {
-            "type": "array",
-            "items": {
-                "$ref": "#/definitions/api.Ticker"
-            }
-        },
         "blockatlas.Collection": {
             "type": "object",
             "properties": {
@@ -1181,6 +1783,40 @@ var doc = `{
                 }
             }
         },
+        "blockatlas.Fee": {
+            "type": "object",
+            "properties": {
+                "coin": {
+                    "type": "integer"
+                },
+                "fast": {
+                    "type": "string"
+                },
+                "gas_limit": {
+                    "type": "string"
+                },
+                "normal": {
+                    "type": "string"
+                },
+                "slow": {
+                    "type": "string"
+                },
+                "unit": {
+                    "type": "string"
+                }
+            }
+        },
+        "blockatlas.Link": {
+            "type": "object",
+            "properties": {
+                "name": {
+                    "type": "string"
+                },
+                "url": {
+                    "type": "string"
+                }
+            }
+        },
         "blockatlas.Resolved": {
             "type": "object",
             "properties": {
@@ -1192,6 +1828,20 @@ var doc = `{
                 }
             }
         },
+        "blockatlas.SocialLink": {
+            "type": "object",
+            "properties": {
+                "handle": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "url": {
+                    "type": "string"
+                }
+            }
+        },
         "blockatlas.StakeValidator": {
             "type": "object",
             "properties": {
@@ -1254,6 +1904,94 @@ var doc = `{
                 }
             }
         },
+        "blockatlas.Subscriptions": {
+            "type": "object",
+            "additionalProperties": {
+                "type": "array",
+                "items": {
+                    "type": "string"
+                }
+            }
+        },
+        "blockatlas.Ticker": {
+            "type": "object",
+            "properties": {
+                "coin": {
+                    "type": "integer"
+                },
+                "coin_name": {
+                    "type": "string"
+                },
+                "error": {
+                    "type": "string"
+                },
+                "last_update": {
+                    "type": "string"
+                },
+                "price": {
+                    "type": "object",
+                    "$ref": "#/definitions/blockatlas.TickerPrice"
+                },
+                "token_id": {
+                    "type": "string"
+                },
+                "type": {
+                    "type": "string"
+                }
+            }
+        },
+        "blockatlas.TickerPrice": {
+            "type": "object",
+            "properties": {
+                "change_24h": {
+                    "type": "number"
+                },
+                "currency": {
+                    "type": "string"
+                },
+                "provider": {
+                    "type": "string"
+                },
+                "value": {
+                    "type": "number"
+                }
+            }
+        },
+        "blockatlas.TickerResponse": {
+            "type": "object",
+            "properties": {
+                "currency": {
+                    "type": "string"
+                },
+                "docs": {
+                    "type": "object",
+                    "$ref": "#/definitions/blockatlas.Tickers"
+                }
+            }
+        },
+        "blockatlas.Tickers": {
+            "type": "array",
+            "items": {
+                "$ref": "#/definitions/blockatlas.Ticker"
+            }
+        },
+        "blockatlas.TokenBalance": {
+            "type": "object",
+            "properties": {
+                "balance": {
+                    "type": "string"
+                },
+                "decimals": {
+                    "type": "integer"
+                },
+                "symbol": {
+                    "type": "string"
+                },
+                "token_id": {
+                    "type": "string"
+                }
+            }
+        },
         "blockatlas.Tx": {
             "type": "object",
             "properties": {
@@ -1328,12 +2066,45 @@ var doc = `{
                 }
             }
         },
+        "blockatlas.TxCursor": {
+            "type": "object",
+            "properties": {
+                "block": {
+                    "type": "integer"
+                },
+                "id": {
+                    "type": "string"
+                }
+            }
+        },
+        "blockatlas.TxCursorPage": {
+            "type": "object",
+            "properties": {
+                "nextCursor": {
+                    "type": "object",
+                    "$ref": "#/definitions/blockatlas.TxCursor"
+                },
+                "txs": {
+                    "type": "object",
+                    "$ref": "#/definitions/blockatlas.TxPage"
+                }
+            }
+        },
         "blockatlas.TxOutput": {
             "type": "object",
             "properties": {
                 "address": {
                     "type": "string"
                 },
+                "n": {
+                    "type": "integer"
+                },
+                "sequence": {
+                    "type": "integer"
+                },
+                "txid": {
+                    "type": "string"
+                },
                 "value": {
                     "type": "string"
                 }
@@ -1373,37 +2144,17 @@ var doc = `{
                 }
             }
         },
-        "watchmarket.ChartCoinInfo": {
+        "markets.Asset": {
             "type": "object",
             "properties": {
-                "circulating_supply": {
-                    "type": "number"
-                },
-                "info": {
-                    "type": "CoinInfo"
-                },
-                "market_cap": {
-                    "type": "number"
-                },
-                "total_supply": {
-                    "type": "number"
+                "coin": {
+                    "type": "integer"
                 },
-                "volume_24": {
-                    "type": "number"
-                }
-            }
-        },
-        "watchmarket.ChartData": {
-            "type": "object",
-            "properties": {
-                "error": {
+                "token_id": {
                     "type": "string"
                 },
-                "prices": {
-                    "type": "array",
-                    "items": {
-                        "type": "ChartPrice"
-                    }
+                "type": {
+                    "type": "string"
                 }
             }
         }
@@ -4,11 +4,16 @@ import (
 	"flag"
 	"fmt"
 	"github.com/gin-gonic/gin"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
 	"github.com/trustwallet/blockatlas/config"
 	"github.com/trustwallet/blockatlas/mq"
+	"github.com/trustwallet/blockatlas/pkg/cache"
 	"github.com/trustwallet/blockatlas/pkg/ginutils"
 	"github.com/trustwallet/blockatlas/pkg/logger"
+	"github.com/trustwallet/blockatlas/services/markets"
+	"github.com/trustwallet/blockatlas/services/markets/coingecko"
 	"github.com/trustwallet/blockatlas/storage"
+	"net/http"
 	"path/filepath"
 	"runtime"
 	"time"
@@ -40,6 +45,61 @@ func InitRedis(host string) *storage.Storage {
 	return cache
 }
 
+func InitPostgres(host string) *storage.SqlStorage {
+	db := storage.NewSql()
+	err := db.Init(host)
+	if err != nil {
+		logger.Fatal(err)
+	}
+	err = db.Migrate()
+	if err != nil {
+		logger.Fatal(err)
+	}
+	return db
+}
+
+// InitStorage connects to the subscriptions storage selected by backend, Redis is used by default
+func InitStorage(backend, redisHost, postgresHost string) storage.Backend {
+	switch backend {
+	case storage.BackendPostgres:
+		return InitPostgres(postgresHost)
+	case storage.BackendRedis, "":
+		cache := InitRedis(redisHost)
+		go storage.RestoreConnectionWorker(cache, redisHost, time.Second*10)
+		return cache
+	default:
+		logger.Fatal("Unknown storage backend", logger.Params{"backend": backend})
+		return nil
+	}
+}
+
+// InitCache selects the backend of the response cache, the memory of the process is used by default
+// and Redis shares the cached responses between the replicas
+func InitCache(backend, redisHost string) {
+	switch backend {
+	case "memory", "":
+	case "redis":
+		b, err := cache.NewRedisBackend(redisHost)
+		if err != nil {
+			logger.Fatal(err)
+		}
+		cache.SetBackend(b)
+	default:
+		logger.Fatal("Unknown cache backend", logger.Params{"backend": backend})
+	}
+}
+
+// InitMarketProvider creates the market data provider selected by name, CoinGecko is used by default
+func InitMarketProvider(name, api string) markets.Provider {
+	switch name {
+	case markets.ProviderCoingecko, "":
+		return coingecko.InitProvider(api)
+	default:
+		logger.Fatal("Unknown market provider", logger.Params{"provider": name})
+		return nil
+	}
+}
+
 func InitConfig(confPath string) {
 	confPath, err := filepath.Abs(confPath)
 	if err != nil {
@@ -81,3 +141,18 @@ Go Version: %s
 `,
 		Build, Date, runtime.GOOS, runtime.GOARCH, runtime.Version())
 }
+
+// InitMetrics serves the Prometheus /metrics endpoint of the workers without an API engine, empty address disables it
+func InitMetrics(address string) {
+	if address == "" {
+		return
+	}
+	mux := http.NewServeMux()
+	mux.Handle("/metrics", promhttp.Handler())
+	go func() {
+		if err := http.ListenAndServe(address, mux); err != nil {
+			logger.Fatal("Metrics listener failed", err, logger.Params{"address": address})
+		}
+	}()
+	logger.Info("Serving metrics", logger.Params{"address": address})
+}
@@ -43,3 +43,23 @@ func SetupGracefulShutdown(port string, engine *gin.Engine) {
 	logger.Info("Stop signal Received", stop)
 	logger.Info("Waiting for all jobs to stop")
 }
+
+// ShutdownContext returns a context which is cancelled when the process receives a stop signal,
+// the workers use it to abort the upstream calls in flight and drain their pipelines
+func ShutdownContext() context.Context {
+	ctx, cancel := context.WithCancel(context.Background())
+	signalForExit := make(chan os.Signal, 1)
+	signal.Notify(signalForExit,
+		syscall.SIGHUP,
+		syscall.SIGINT,
+		syscall.SIGTERM,
+		syscall.SIGQUIT)
+
+	go func() {
+		stop := <-signalForExit
+		logger.Info("Stop signal Received", stop)
+		logger.Info("Waiting for all jobs to stop")
+		cancel()
+	}()
+	return ctx
+}
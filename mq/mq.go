@@ -1,27 +1,48 @@
 package mq
 
 import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
 	"github.com/streadway/amqp"
+	"github.com/trustwallet/blockatlas/pkg/errors"
 	"github.com/trustwallet/blockatlas/pkg/logger"
 	"github.com/trustwallet/blockatlas/storage"
+	"sync"
 	"time"
 )
 
 var (
 	PrefetchCount int
-	amqpChan      *amqp.Channel
-	conn          *amqp.Connection
-	queue         amqp.Queue
+	// ConfirmTimeout is how long Publish waits for the broker to confirm a message
+	ConfirmTimeout = time.Second * 10
+	amqpChan       *amqp.Channel
+	conn           *amqp.Connection
+	queue          amqp.Queue
+
+	consumed = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "blockatlas",
+		Subsystem: "mq",
+		Name:      "consumed_messages_total",
+		Help:      "Messages received by the queue consumers",
+	}, []string{"queue"})
+
+	// The channel is in the confirm mode, publishMu guards the publishing and the delivery tags,
+	// the confirmations are dispatched to the waiting publishers by their delivery tag
+	publishMu   sync.Mutex
+	pending     map[uint64]chan amqp.Confirmation
+	deliveryTag uint64
 )
 
 type (
 	Queue    string
-	Consumer func(amqp.Delivery, storage.Addresses)
+	Consumer func(amqp.Delivery, storage.Backend)
 )
 
 const (
-	Transactions         Queue = "transactions"
-	Subscriptions        Queue = "subscriptions"
+	Transactions  Queue = "transactions"
+	Subscriptions Queue = "subscriptions"
+	// SubscriptionsDead quarantines the subscription messages which can't be processed
+	SubscriptionsDead    Queue = "subscriptions_dead"
 	defaultPrefetchCount       = 5
 	minPrefetchCount           = 1
 )
@@ -35,9 +56,40 @@ func Init(uri string) (err error) {
 	if err != nil {
 		return
 	}
+	publishMu.Lock()
+	defer publishMu.Unlock()
+	err = amqpChan.Confirm(false)
+	if err != nil {
+		return
+	}
+	confirms := amqpChan.NotifyPublish(make(chan amqp.Confirmation, 64))
+	pending = make(map[uint64]chan amqp.Confirmation)
+	deliveryTag = 0
+	go dispatchConfirms(confirms, pending)
 	return
 }
 
+// dispatchConfirms passes the confirmations of the channel to their publishers,
+// the publishers still waiting when the channel closes are released without one
+func dispatchConfirms(confirms <-chan amqp.Confirmation, waiting map[uint64]chan amqp.Confirmation) {
+	for confirm := range confirms {
+		publishMu.Lock()
+		confirmed, ok := waiting[confirm.DeliveryTag]
+		delete(waiting, confirm.DeliveryTag)
+		publishMu.Unlock()
+		// The publishers which timed out are not waiting anymore
+		if ok {
+			confirmed <- confirm
+		}
+	}
+	publishMu.Lock()
+	defer publishMu.Unlock()
+	for tag, confirmed := range waiting {
+		close(confirmed)
+		delete(waiting, tag)
+	}
+}
+
 func Close() {
 	amqpChan.Close()
 	conn.Close()
@@ -48,15 +100,58 @@ func (q Queue) Declare() error {
 	return err
 }
 
+// Publish sends a persistent message and waits until the broker confirms it
 func (q Queue) Publish(body []byte) error {
-	return amqpChan.Publish("", string(q), false, false, amqp.Publishing{
+	return q.publish(amqp.Publishing{
+		DeliveryMode: amqp.Persistent,
+		ContentType:  "text/plain",
+		Body:         body,
+	})
+}
+
+// PublishError sends a message which failed to be processed, the reason goes to the x-error header
+func (q Queue) PublishError(body []byte, reason error) error {
+	return q.publish(amqp.Publishing{
+		Headers:      amqp.Table{"x-error": reason.Error()},
 		DeliveryMode: amqp.Persistent,
 		ContentType:  "text/plain",
 		Body:         body,
 	})
 }
 
-func (q Queue) RunConsumer(consumer Consumer, cache storage.Addresses) {
+func (q Queue) publish(msg amqp.Publishing) error {
+	publishMu.Lock()
+	err := amqpChan.Publish("", string(q), false, false, msg)
+	if err != nil {
+		publishMu.Unlock()
+		return errors.E(err, errors.Params{"queue": q})
+	}
+	deliveryTag++
+	tag, waiting := deliveryTag, pending
+	confirmed := make(chan amqp.Confirmation, 1)
+	waiting[tag] = confirmed
+	publishMu.Unlock()
+
+	timeout := time.NewTimer(ConfirmTimeout)
+	defer timeout.Stop()
+	select {
+	case confirm, ok := <-confirmed:
+		if !ok {
+			return errors.E("channel closed before the confirmation", errors.Params{"queue": q})
+		}
+		if !confirm.Ack {
+			return errors.E("message was rejected by the broker", errors.Params{"queue": q})
+		}
+		return nil
+	case <-timeout.C:
+		publishMu.Lock()
+		delete(waiting, tag)
+		publishMu.Unlock()
+		return errors.E("confirmation timeout", errors.Params{"queue": q})
+	}
+}
+
+func (q Queue) RunConsumer(consumer Consumer, cache storage.Backend) {
 	messageChannel, err := amqpChan.Consume(
 		string(q),
 		"",
@@ -87,6 +182,7 @@ func (q Queue) RunConsumer(consumer Consumer, cache storage.Addresses) {
 	}
 
 	for data := range messageChannel {
+		consumed.WithLabelValues(string(q)).Inc()
 		go consumer(data, cache)
 	}
 }
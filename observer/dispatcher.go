@@ -4,19 +4,47 @@ import (
 	"encoding/json"
 	"github.com/trustwallet/blockatlas/mq"
 	"github.com/trustwallet/blockatlas/pkg/blockatlas"
-	"github.com/trustwallet/blockatlas/pkg/errors"
 	"github.com/trustwallet/blockatlas/pkg/logger"
+	"github.com/trustwallet/blockatlas/storage"
 	"net/http"
+	"sync"
+	"time"
 )
 
 type Dispatcher struct {
 	Client http.Client
+	// Outbox keeps the events until their delivery is confirmed, the events are lost on failures without it
+	Outbox storage.Outbox
+	// MaxAttempts moves an event to the dead letters after that many failed deliveries
+	MaxAttempts int
+	// Webhooks switches the delivery from the transactions queue to the callback urls of the guids
+	Webhooks storage.Webhooks
+	// Secret signs the webhook bodies of the guids without their own secret
+	Secret string
+	// MaxElapsedTime limits the retries of a failed webhook delivery, 0 uses the backoff default
+	MaxElapsedTime time.Duration
+
+	inFlight sync.Map
+	wg       sync.WaitGroup
 }
 
 type DispatchEvent struct {
 	Action blockatlas.TransactionType `json:"action"`
 	Result *blockatlas.Tx             `json:"result"`
 	GUID   string                     `json:"guid"`
+	// Reverted is set when the transaction was dropped by a chain reorganization
+	// and the previous notification about it is not valid anymore
+	Reverted bool `json:"reverted,omitempty"`
+}
+
+// NewDispatchEvent builds the message delivered for the event
+func NewDispatchEvent(event Event) DispatchEvent {
+	return DispatchEvent{
+		Action:   event.Tx.Type,
+		Result:   event.Tx,
+		GUID:     event.Subscription.GUID,
+		Reverted: event.Reverted,
+	}
 }
 
 func (d *Dispatcher) Run(events <-chan Event) {
@@ -28,13 +56,7 @@ func (d *Dispatcher) Run(events <-chan Event) {
 func (d *Dispatcher) dispatch(event Event) {
 	guid := event.Subscription.GUID
 
-	action := DispatchEvent{
-		Action: event.Tx.Type,
-		Result: event.Tx,
-		GUID:   guid,
-	}
-
-	txJson, err := json.Marshal(action)
+	txJson, err := json.Marshal(NewDispatchEvent(event))
 	if err != nil {
 		logger.Panic(err)
 	}
@@ -44,17 +66,49 @@ func (d *Dispatcher) dispatch(event Event) {
 		"coin": event.Subscription.Coin,
 		"txID": event.Tx.ID,
 	}
+	if event.Reverted {
+		logParams["reverted"] = true
+	}
+	if event.Tx.Status == blockatlas.StatusPending {
+		logParams["pending"] = true
+	}
 
-	go d.postMessageToQueue(guid, txJson, logParams)
+	if d.Outbox != nil {
+		d.store(guid, txJson, logParams)
+	} else {
+		d.goDeliver(func() { d.send(guid, txJson, logParams) })
+	}
 
 	logger.Info("Dispatching messages...", logParams)
 }
 
-func (d *Dispatcher) postMessageToQueue(message string, rawMessage []byte, logParams logger.Params) {
-	err := mq.Transactions.Publish(rawMessage)
+// Wait blocks until the deliveries in progress are finished
+func (d *Dispatcher) Wait() {
+	d.wg.Wait()
+}
+
+func (d *Dispatcher) goDeliver(deliver func()) {
+	d.wg.Add(1)
+	go func() {
+		defer d.wg.Done()
+		deliver()
+	}()
+}
+
+func (d *Dispatcher) send(guid string, rawMessage []byte, logParams logger.Params) {
+	err := d.publish(guid, rawMessage, logParams)
 	if err != nil {
-		err = errors.E(err, "Failed to dispatch event", errors.Params{"message": message}, logParams)
-		logger.Fatal(err, logger.Params{"message": message}, logParams)
+		eventsDispatched.WithLabelValues("failed").Inc()
+		logger.Error(err, "Failed to dispatch event", logParams)
+		return
+	}
+	eventsDispatched.WithLabelValues("delivered").Inc()
+	logger.Info("Message dispatched", logParams)
+}
+
+func (d *Dispatcher) publish(guid string, rawMessage []byte, logParams logger.Params) error {
+	if d.Webhooks != nil {
+		return d.postWebhook(guid, rawMessage, logParams)
 	}
-	logger.Info("Message dispatched", logger.Params{"message": message}, logParams)
+	return mq.Transactions.Publish(rawMessage)
 }
@@ -0,0 +1,131 @@
+package observer
+
+import (
+	"context"
+	"github.com/trustwallet/blockatlas/pkg/blockatlas"
+	"github.com/trustwallet/blockatlas/pkg/logger"
+	"sync"
+	"time"
+)
+
+// confirmedCacheSize is the number of confirmed transaction ids kept
+// to drop the pending transactions reported after their block
+const confirmedCacheSize = 20000
+
+// MempoolStream polls the unconfirmed transactions and emits the new ones as a block without a number
+type MempoolStream struct {
+	MempoolAPI   blockatlas.MempoolAPI
+	PollInterval time.Duration
+	logParams    logger.Params
+	known        map[string]bool
+}
+
+func (s *MempoolStream) Execute(ctx context.Context) <-chan *blockatlas.Block {
+	s.logParams = logger.Params{"platform": s.MempoolAPI.Coin().Handle}
+	s.known = make(map[string]bool)
+	c := make(chan *blockatlas.Block)
+	go s.run(ctx, c)
+	return c
+}
+
+func (s *MempoolStream) run(ctx context.Context, c chan<- *blockatlas.Block) {
+	ticker := time.NewTicker(s.PollInterval)
+	for {
+		select {
+		case <-ctx.Done():
+			ticker.Stop()
+			close(c)
+			return
+		case <-ticker.C:
+			s.load(ctx, c)
+		}
+	}
+}
+
+func (s *MempoolStream) load(ctx context.Context, c chan<- *blockatlas.Block) {
+	txs, err := blockatlas.MempoolAPIContext(s.MempoolAPI).GetMempoolTxsWithContext(ctx)
+	if err != nil && ctx.Err() != nil {
+		return
+	}
+	if err != nil {
+		logger.Error(err, "Polling failed: source didn't return the mempool", s.logParams)
+		return
+	}
+	newTxs := s.newTxs(txs, time.Now().Unix())
+	if len(newTxs) == 0 {
+		return
+	}
+	logger.Info("Got new pending transactions", s.logParams, logger.Params{"txs": len(newTxs)})
+	select {
+	case <-ctx.Done():
+	case c <- &blockatlas.Block{Txs: newTxs}:
+	}
+}
+
+// newTxs returns the transactions which weren't part of the previous poll,
+// the transactions without a date are dated to the time they were first seen
+func (s *MempoolStream) newTxs(txs blockatlas.TxPage, now int64) []blockatlas.Tx {
+	known := make(map[string]bool, len(txs))
+	result := make([]blockatlas.Tx, 0)
+	for _, tx := range txs {
+		known[tx.ID] = true
+		if s.known[tx.ID] {
+			continue
+		}
+		tx.Status = blockatlas.StatusPending
+		if tx.Date == 0 {
+			tx.Date = now
+		}
+		result = append(result, tx)
+	}
+	s.known = known
+	return result
+}
+
+// Merge forwards the blocks of all the channels, the returned channel is closed once all of them are closed
+func Merge(channels ...<-chan *blockatlas.Block) <-chan *blockatlas.Block {
+	out := make(chan *blockatlas.Block)
+	var wg sync.WaitGroup
+	wg.Add(len(channels))
+	for _, c := range channels {
+		go func(c <-chan *blockatlas.Block) {
+			for block := range c {
+				out <- block
+			}
+			wg.Done()
+		}(c)
+	}
+	go func() {
+		wg.Wait()
+		close(out)
+	}()
+	return out
+}
+
+// txIDCache remembers the most recent transaction ids, the oldest id is evicted when full
+type txIDCache struct {
+	ids  map[string]bool
+	ring []string
+	next int
+}
+
+func newTxIDCache(size int) *txIDCache {
+	return &txIDCache{
+		ids:  make(map[string]bool, size),
+		ring: make([]string, size),
+	}
+}
+
+func (c *txIDCache) add(id string) {
+	if c.ids[id] {
+		return
+	}
+	delete(c.ids, c.ring[c.next])
+	c.ring[c.next] = id
+	c.ids[id] = true
+	c.next = (c.next + 1) % len(c.ring)
+}
+
+func (c *txIDCache) contains(id string) bool {
+	return c.ids[id]
+}
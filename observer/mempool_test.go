@@ -0,0 +1,92 @@
+package observer
+
+import (
+	"github.com/stretchr/testify/assert"
+	"github.com/trustwallet/blockatlas/coin"
+	"github.com/trustwallet/blockatlas/pkg/blockatlas"
+	"testing"
+)
+
+type subscriptionsStub struct {
+	subs []blockatlas.Subscription
+}
+
+func (s *subscriptionsStub) Lookup(coin uint, addresses []string) ([]blockatlas.Subscription, error) {
+	return s.subs, nil
+}
+
+func (s *subscriptionsStub) AddSubscriptions(subscriptions []blockatlas.Subscription) error {
+	return nil
+}
+
+func (s *subscriptionsStub) DeleteSubscriptions(subscriptions []blockatlas.Subscription) error {
+	return nil
+}
+
+func (s *subscriptionsStub) GetSubscriptions(guid string) ([]blockatlas.Subscription, error) {
+	return s.subs, nil
+}
+
+func TestMempoolStream_newTxs(t *testing.T) {
+	s := MempoolStream{known: make(map[string]bool)}
+
+	txs := s.newTxs(blockatlas.TxPage{{ID: "a"}, {ID: "b", Date: 100}}, 200)
+	assert.Equal(t, []blockatlas.Tx{
+		{ID: "a", Date: 200, Status: blockatlas.StatusPending},
+		{ID: "b", Date: 100, Status: blockatlas.StatusPending},
+	}, txs)
+
+	txs = s.newTxs(blockatlas.TxPage{{ID: "b"}, {ID: "c"}}, 300)
+	assert.Equal(t, []blockatlas.Tx{{ID: "c", Date: 300, Status: blockatlas.StatusPending}}, txs)
+
+	// Transactions which left the mempool are forgotten
+	assert.False(t, s.known["a"])
+}
+
+func TestTxIDCache(t *testing.T) {
+	c := newTxIDCache(2)
+	c.add("a")
+	c.add("b")
+	c.add("b")
+	assert.True(t, c.contains("a"))
+	c.add("c")
+	assert.False(t, c.contains("a"))
+	assert.True(t, c.contains("b"))
+	assert.True(t, c.contains("c"))
+}
+
+func TestObserver_pending(t *testing.T) {
+	address := "tbnb1sylyjw032eajr9cyllp26n04300qzzre38qyv5"
+	storage := &subscriptionsStub{subs: []blockatlas.Subscription{{Coin: coin.BNB, Address: address, GUID: "guid"}}}
+	o := Observer{Storage: storage, Coin: coin.BNB, Pending: true}
+
+	pending := transferDst1
+	pending.Status = blockatlas.StatusPending
+	blocks := make(chan *blockatlas.Block, 3)
+	blocks <- &blockatlas.Block{Txs: []blockatlas.Tx{pending}}
+	blocks <- &blockatlas.Block{Number: 7761368, Txs: []blockatlas.Tx{transferDst1}}
+	// The mempool can still report the transaction after its block
+	blocks <- &blockatlas.Block{Txs: []blockatlas.Tx{pending}}
+	close(blocks)
+
+	var statuses []blockatlas.Status
+	for event := range o.Execute(blocks) {
+		statuses = append(statuses, event.Tx.Status)
+	}
+	assert.Equal(t, []blockatlas.Status{blockatlas.StatusPending, blockatlas.StatusCompleted}, statuses)
+}
+
+func TestMerge(t *testing.T) {
+	a := make(chan *blockatlas.Block, 1)
+	b := make(chan *blockatlas.Block, 1)
+	a <- &blockatlas.Block{Number: 1}
+	b <- &blockatlas.Block{Number: 2}
+	close(a)
+	close(b)
+
+	var sum int64
+	for block := range Merge(a, b) {
+		sum += block.Number
+	}
+	assert.Equal(t, int64(3), sum)
+}
@@ -0,0 +1,36 @@
+package observer
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+var (
+	blockLag = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: "blockatlas",
+		Subsystem: "observer",
+		Name:      "block_lag",
+		Help:      "Chain head minus the tracker height",
+	}, []string{"platform"})
+
+	blocksFetched = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "blockatlas",
+		Subsystem: "observer",
+		Name:      "blocks_fetched_total",
+		Help:      "Blocks fetched from the platforms, the status is \"error\" if all the retries failed",
+	}, []string{"platform", "status"})
+
+	blockRetries = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "blockatlas",
+		Subsystem: "observer",
+		Name:      "block_retries_total",
+		Help:      "Retried block requests by platform",
+	}, []string{"platform"})
+
+	eventsDispatched = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "blockatlas",
+		Subsystem: "observer",
+		Name:      "events_dispatched_total",
+		Help:      "Transaction events by the delivery result: delivered, failed or dead",
+	}, []string{"result"})
+)
@@ -13,27 +13,49 @@ import (
 type Event struct {
 	Subscription blockatlas.Subscription
 	Tx           *blockatlas.Tx
+	// Reverted is set when the transaction was dropped by a chain reorganization
+	Reverted bool
 }
 
 type Observer struct {
 	Storage storage.Addresses
 	Coin    uint
+	// Pending is set when the blocks are merged with the mempool stream,
+	// the pending transactions which were already seen in a block are skipped
+	Pending bool
+	// Xpubs extends the derived addresses of the xpubs which have transactions, nil disables it
+	Xpubs     *XpubExtender
+	confirmed *txIDCache
 }
 
 func (o *Observer) Execute(blocks <-chan *blockatlas.Block) <-chan Event {
+	if o.Pending {
+		o.confirmed = newTxIDCache(confirmedCacheSize)
+	}
 	events := make(chan Event)
 	go o.run(events, blocks)
 	return events
 }
 
 func (o *Observer) run(events chan<- Event, blocks <-chan *blockatlas.Block) {
+	defer close(events)
 	for block := range blocks {
 		o.processBlock(events, block)
 	}
 }
 
 func (o *Observer) processBlock(events chan<- Event, block *blockatlas.Block) {
-	txMap := GetTxs(block)
+	if o.confirmed != nil {
+		o.processTxs(events, groupTxsByAddress(o.skipConfirmed(block.Txs)), false)
+	} else {
+		o.processTxs(events, GetTxs(block), false)
+	}
+	if len(block.RevertedTxs) > 0 {
+		o.processTxs(events, groupTxsByAddress(block.RevertedTxs), true)
+	}
+}
+
+func (o *Observer) processTxs(events chan<- Event, txMap map[string]*blockatlas.TxSet, reverted bool) {
 	if len(txMap) == 0 {
 		return
 	}
@@ -52,39 +74,104 @@ func (o *Observer) processBlock(events chan<- Event, block *blockatlas.Block) {
 	if err != nil || len(subs) == 0 {
 		return
 	}
+	if o.Xpubs != nil && !reverted {
+		o.Xpubs.Extend(o.Coin, subscribedAddresses(subs))
+	}
+	wallets := groupAddressesByGUID(subs)
+	notified := make(map[string]bool)
 	for _, sub := range subs {
 		tx, ok := txMap[sub.Address]
 		if !ok {
 			continue
 		}
 		for _, tx := range tx.Txs() {
-			tx.Direction = getDirection(tx, sub.Address)
-			inferUtxoValue(&tx, sub.Address, o.Coin)
+			if isUtxo(tx) {
+				// The addresses of a wallet are evaluated together, so the wallet gets
+				// a single event with the net amount instead of one event per address
+				key := sub.GUID + "-" + tx.ID
+				if notified[key] {
+					continue
+				}
+				notified[key] = true
+				addressSet := wallets[sub.GUID]
+				tx.Direction = bitcoin.InferDirection(&tx, addressSet)
+				inferUtxoValue(&tx, addressSet, o.Coin)
+			} else {
+				tx.Direction = getDirection(tx, sub.Address)
+			}
 			events <- Event{
 				Subscription: sub,
 				Tx:           &tx,
+				Reverted:     reverted,
 			}
 		}
 	}
 }
 
+func subscribedAddresses(subs []blockatlas.Subscription) []string {
+	unique := make(map[string]bool, len(subs))
+	addresses := make([]string, 0, len(subs))
+	for _, sub := range subs {
+		if !unique[sub.Address] {
+			unique[sub.Address] = true
+			addresses = append(addresses, sub.Address)
+		}
+	}
+	return addresses
+}
+
+// groupAddressesByGUID builds the set of the subscribed addresses of every wallet
+func groupAddressesByGUID(subs []blockatlas.Subscription) map[string]mapset.Set {
+	wallets := make(map[string]mapset.Set)
+	for _, sub := range subs {
+		if wallets[sub.GUID] == nil {
+			wallets[sub.GUID] = mapset.NewSet()
+		}
+		wallets[sub.GUID].Add(sub.Address)
+	}
+	return wallets
+}
+
+// skipConfirmed drops the pending transactions which were already seen in a block
+// and remembers the confirmed ones
+func (o *Observer) skipConfirmed(txs []blockatlas.Tx) []blockatlas.Tx {
+	result := make([]blockatlas.Tx, 0, len(txs))
+	for _, tx := range txs {
+		if tx.Status != blockatlas.StatusPending {
+			o.confirmed.add(tx.ID)
+		} else if o.confirmed.contains(tx.ID) {
+			continue
+		}
+		result = append(result, tx)
+	}
+	return result
+}
+
 func GetTxs(block *blockatlas.Block) map[string]*blockatlas.TxSet {
+	return groupTxsByAddress(block.Txs)
+}
+
+func groupTxsByAddress(txs []blockatlas.Tx) map[string]*blockatlas.TxSet {
 	txMap := make(map[string]*blockatlas.TxSet)
-	for i := 0; i < len(block.Txs); i++ {
-		addresses := block.Txs[i].GetAddresses()
-		addresses = append(addresses, block.Txs[i].GetUtxoAddresses()...)
+	for i := 0; i < len(txs); i++ {
+		addresses := txs[i].GetAddresses()
+		addresses = append(addresses, txs[i].GetUtxoAddresses()...)
 		for _, address := range addresses {
 			if txMap[address] == nil {
 				txMap[address] = new(blockatlas.TxSet)
 			}
-			txMap[address].Add(&block.Txs[i])
+			txMap[address].Add(&txs[i])
 		}
 	}
 	return txMap
 }
 
+func isUtxo(tx blockatlas.Tx) bool {
+	return len(tx.Inputs) > 0 && len(tx.Outputs) > 0
+}
+
 func getDirection(tx blockatlas.Tx, address string) blockatlas.Direction {
-	if len(tx.Inputs) > 0 && len(tx.Outputs) > 0 {
+	if isUtxo(tx) {
 		addressSet := mapset.NewSet(address)
 		return bitcoin.InferDirection(&tx, addressSet)
 	}
@@ -108,9 +195,8 @@ func determineDirection(address, from, to string) blockatlas.Direction {
 	return blockatlas.DirectionOutgoing
 }
 
-func inferUtxoValue(tx *blockatlas.Tx, address string, coinIndex uint) {
-	if len(tx.Inputs) > 0 && len(tx.Outputs) > 0 {
-		addressSet := mapset.NewSet(address)
+func inferUtxoValue(tx *blockatlas.Tx, addressSet mapset.Set, coinIndex uint) {
+	if isUtxo(*tx) {
 		value := bitcoin.InferValue(tx, tx.Direction, addressSet)
 		tx.Meta = blockatlas.Transfer{
 			Value:    value,
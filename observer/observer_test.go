@@ -1,6 +1,7 @@
 package observer
 
 import (
+	mapset "github.com/deckarep/golang-set"
 	"github.com/stretchr/testify/assert"
 	"github.com/trustwallet/blockatlas/coin"
 	"github.com/trustwallet/blockatlas/pkg/blockatlas"
@@ -277,7 +278,7 @@ func Test_inferUtxoValue(t *testing.T) {
 						{Address: "32yRH5tNnFtAXE844wNrHN7Bf3SBcb3Uhd", Value: "1268998877"},
 					},
 				}, "32yRH5tNnFtAXE844wNrHN7Bf3SBcb3Uhd", 0,
-			}, blockatlas.Amount("4471835"),
+			}, blockatlas.Amount("6071835"),
 		},
 		{"Test UTXO Direction Incoming",
 			args{
@@ -302,7 +303,7 @@ func Test_inferUtxoValue(t *testing.T) {
 				Decimals: coin.Coins[tt.args.coinIndex].Decimals,
 			}
 			tt.args.tx.Direction = getDirection(tt.args.tx, tt.args.address)
-			if inferUtxoValue(&tt.args.tx, tt.args.address, tt.args.coinIndex); tt.args.tx.Meta != expect {
+			if inferUtxoValue(&tt.args.tx, mapset.NewSet(tt.args.address), tt.args.coinIndex); tt.args.tx.Meta != expect {
 				t.Errorf("inferUtxoValue() = %v, want %v", tt.args.tx.Meta, expect)
 			}
 		})
@@ -355,3 +356,76 @@ func TestGetInterval(t *testing.T) {
 		})
 	}
 }
+
+func TestObserver_wallet(t *testing.T) {
+	tx := blockatlas.Tx{
+		ID:   "a2d70bee124510c476f159fa83cdb34d663fc6020c81aad19b238601d679fed7",
+		Coin: coin.BTC,
+		Inputs: []blockatlas.TxOutput{
+			{Address: "3QJmV3qfvL9SuYo34YihAf3sRCW3qSinyC", Value: "1600"},
+		},
+		Outputs: []blockatlas.TxOutput{
+			{Address: "3FjBW1KL9L8aYtdKzJ8FhCNxmXB7dXDRw4", Value: "500"},
+			{Address: "3BMEXVshYmWqc8qcQLyBQPgRgAPfogWdJ4", Value: "1000"},
+		},
+	}
+	storage := &subscriptionsStub{subs: []blockatlas.Subscription{
+		{Coin: coin.BTC, Address: "3QJmV3qfvL9SuYo34YihAf3sRCW3qSinyC", GUID: "sender"},
+		{Coin: coin.BTC, Address: "3FjBW1KL9L8aYtdKzJ8FhCNxmXB7dXDRw4", GUID: "sender"},
+		{Coin: coin.BTC, Address: "3BMEXVshYmWqc8qcQLyBQPgRgAPfogWdJ4", GUID: "receiver"},
+	}}
+	o := Observer{Storage: storage, Coin: coin.BTC}
+
+	blocks := make(chan *blockatlas.Block, 1)
+	blocks <- &blockatlas.Block{Txs: []blockatlas.Tx{tx}}
+	close(blocks)
+
+	events := make(map[string]blockatlas.Tx)
+	for event := range o.Execute(blocks) {
+		_, ok := events[event.Subscription.GUID]
+		assert.False(t, ok, "duplicated event of %s", event.Subscription.GUID)
+		events[event.Subscription.GUID] = *event.Tx
+	}
+	assert.Len(t, events, 2)
+
+	// The change returned to the wallet is not a part of the sent amount
+	assert.Equal(t, blockatlas.DirectionOutgoing, events["sender"].Direction)
+	assert.Equal(t, blockatlas.Amount("1000"), events["sender"].Meta.(blockatlas.Transfer).Value)
+
+	assert.Equal(t, blockatlas.DirectionIncoming, events["receiver"].Direction)
+	assert.Equal(t, blockatlas.Amount("1000"), events["receiver"].Meta.(blockatlas.Transfer).Value)
+}
+
+func TestObserver_walletMultipleRecipients(t *testing.T) {
+	tx := blockatlas.Tx{
+		ID:   "0b4c06bdcd2b6a3e8a8f3e9e7f6a8fa6c7b1f1f4f1a2e5d4f0b8e0e4b1f0a1c2",
+		Coin: coin.BTC,
+		Inputs: []blockatlas.TxOutput{
+			{Address: "3QJmV3qfvL9SuYo34YihAf3sRCW3qSinyC", Value: "2600"},
+		},
+		Outputs: []blockatlas.TxOutput{
+			{Address: "3BMEXVshYmWqc8qcQLyBQPgRgAPfogWdJ4", Value: "1000"},
+			{Address: "3FjBW1KL9L8aYtdKzJ8FhCNxmXB7dXDRw4", Value: "500"},
+			{Address: "bc1qxy2kgdygjrsqtzq2n0yrf2493p83kkfjhx0wlh", Value: "700"},
+		},
+	}
+	storage := &subscriptionsStub{subs: []blockatlas.Subscription{
+		{Coin: coin.BTC, Address: "3QJmV3qfvL9SuYo34YihAf3sRCW3qSinyC", GUID: "sender"},
+		{Coin: coin.BTC, Address: "3FjBW1KL9L8aYtdKzJ8FhCNxmXB7dXDRw4", GUID: "sender"},
+	}}
+	o := Observer{Storage: storage, Coin: coin.BTC}
+
+	blocks := make(chan *blockatlas.Block, 1)
+	blocks <- &blockatlas.Block{Txs: []blockatlas.Tx{tx}}
+	close(blocks)
+
+	var events []blockatlas.Tx
+	for event := range o.Execute(blocks) {
+		events = append(events, *event.Tx)
+	}
+	assert.Len(t, events, 1)
+
+	// Every output outside the wallet is a part of the sent amount
+	assert.Equal(t, blockatlas.DirectionOutgoing, events[0].Direction)
+	assert.Equal(t, blockatlas.Amount("1700"), events[0].Meta.(blockatlas.Transfer).Value)
+}
@@ -0,0 +1,159 @@
+package observer
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"github.com/trustwallet/blockatlas/pkg/blockatlas"
+	"github.com/trustwallet/blockatlas/pkg/logger"
+	"github.com/trustwallet/blockatlas/storage"
+	"time"
+)
+
+const (
+	defaultMaxAttempts    = 10
+	defaultOutboxInterval = time.Second * 30
+)
+
+// OutboxID identifies an event by its body, so the same notification is stored once
+func OutboxID(rawMessage []byte) string {
+	sum := sha256.Sum256(rawMessage)
+	return hex.EncodeToString(sum[:])
+}
+
+// store writes the event to the outbox before delivering it,
+// an event which can't be stored is still delivered without the guarantees
+func (d *Dispatcher) store(guid string, rawMessage []byte, logParams logger.Params) {
+	now := time.Now().Unix()
+	event := blockatlas.OutboxEvent{
+		ID:      OutboxID(rawMessage),
+		GUID:    guid,
+		Body:    rawMessage,
+		Created: now,
+		Updated: now,
+	}
+	err := d.Outbox.SaveOutboxEvent(event)
+	if err != nil {
+		logger.Error(err, "Failed to store event in the outbox", logParams)
+		d.goDeliver(func() { d.send(guid, rawMessage, logParams) })
+		return
+	}
+	d.goDeliver(func() { d.deliverOutbox(event, logParams) })
+}
+
+// deliverOutbox publishes a stored event and removes it from the outbox once the delivery is confirmed.
+// A failed event stays in the outbox for the next RunOutbox round or becomes dead after MaxAttempts
+func (d *Dispatcher) deliverOutbox(event blockatlas.OutboxEvent, logParams logger.Params) {
+	if _, loaded := d.inFlight.LoadOrStore(event.ID, struct{}{}); loaded {
+		return
+	}
+	defer d.inFlight.Delete(event.ID)
+
+	err := d.publish(event.GUID, event.Body, logParams)
+	if err == nil {
+		eventsDispatched.WithLabelValues("delivered").Inc()
+		if err := d.Outbox.DeleteOutboxEvent(event.ID); err != nil {
+			logger.Error(err, "Failed to delete delivered event from the outbox", logParams)
+		}
+		logger.Info("Message dispatched", logParams)
+		return
+	}
+
+	event.Attempts++
+	event.Error = err.Error()
+	event.Updated = time.Now().Unix()
+	event.Dead = event.Attempts >= d.maxAttempts()
+	if event.Dead {
+		eventsDispatched.WithLabelValues("dead").Inc()
+		logger.Error(err, "Event moved to the dead letters", logger.Params{"attempts": event.Attempts}, logParams)
+	} else {
+		eventsDispatched.WithLabelValues("failed").Inc()
+		logger.Warn("Failed to dispatch event, it stays in the outbox", logger.Params{"attempts": event.Attempts, "err": err.Error()}, logParams)
+	}
+	if err := d.Outbox.SaveOutboxEvent(event); err != nil {
+		logger.Error(err, "Failed to update event in the outbox", logParams)
+	}
+}
+
+// RunOutbox redelivers the events left in the outbox by failed deliveries or a restart,
+// an event is retried once its last attempt is older than the interval.
+// The delivery is at least once: an event can be sent again if the confirmation was lost
+func (d *Dispatcher) RunOutbox(ctx context.Context, interval time.Duration) {
+	if interval <= 0 {
+		interval = defaultOutboxInterval
+	}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		d.redeliver(time.Now().Add(-interval))
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (d *Dispatcher) redeliver(before time.Time) {
+	events, err := d.Outbox.GetOutboxEvents()
+	if err != nil {
+		logger.Error(err, "Failed to read the outbox")
+		return
+	}
+	for _, event := range events {
+		if event.Dead || event.Updated > before.Unix() {
+			continue
+		}
+		d.deliverOutbox(event, logger.Params{"guid": event.GUID, "id": event.ID, "attempts": event.Attempts})
+	}
+}
+
+func (d *Dispatcher) maxAttempts() int {
+	if d.MaxAttempts <= 0 {
+		return defaultMaxAttempts
+	}
+	return d.MaxAttempts
+}
+
+// DeadLetters returns the events which failed all the delivery attempts
+func DeadLetters(outbox storage.Outbox) ([]blockatlas.OutboxEvent, error) {
+	events, err := outbox.GetOutboxEvents()
+	if err != nil {
+		return nil, err
+	}
+	dead := make([]blockatlas.OutboxEvent, 0)
+	for _, event := range events {
+		if event.Dead {
+			dead = append(dead, event)
+		}
+	}
+	return dead, nil
+}
+
+// ReplayDeadLetters returns the dead events with the given ids, or all of them if empty,
+// to the outbox so RunOutbox delivers them again
+func ReplayDeadLetters(outbox storage.Outbox, ids ...string) (int, error) {
+	dead, err := DeadLetters(outbox)
+	if err != nil {
+		return 0, err
+	}
+	selected := make(map[string]bool, len(ids))
+	for _, id := range ids {
+		selected[id] = true
+	}
+	var replayed int
+	for _, event := range dead {
+		if len(ids) > 0 && !selected[event.ID] {
+			continue
+		}
+		event.Dead = false
+		event.Attempts = 0
+		event.Error = ""
+		event.Updated = 0
+		if err := outbox.SaveOutboxEvent(event); err != nil {
+			return replayed, err
+		}
+		replayed++
+	}
+	return replayed, nil
+}
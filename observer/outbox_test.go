@@ -0,0 +1,109 @@
+package observer
+
+import (
+	"github.com/stretchr/testify/assert"
+	"github.com/trustwallet/blockatlas/pkg/blockatlas"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+type outboxStub struct {
+	sync.Mutex
+	events map[string]blockatlas.OutboxEvent
+}
+
+func (o *outboxStub) SaveOutboxEvent(event blockatlas.OutboxEvent) error {
+	o.Lock()
+	defer o.Unlock()
+	o.events[event.ID] = event
+	return nil
+}
+
+func (o *outboxStub) DeleteOutboxEvent(id string) error {
+	o.Lock()
+	defer o.Unlock()
+	delete(o.events, id)
+	return nil
+}
+
+func (o *outboxStub) GetOutboxEvents() ([]blockatlas.OutboxEvent, error) {
+	o.Lock()
+	defer o.Unlock()
+	events := make([]blockatlas.OutboxEvent, 0, len(o.events))
+	for _, event := range o.events {
+		events = append(events, event)
+	}
+	sort.Slice(events, func(i, j int) bool {
+		return events[i].ID < events[j].ID
+	})
+	return events, nil
+}
+
+func TestDispatcher_deliverOutbox(t *testing.T) {
+	var healthy int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if atomic.LoadInt32(&healthy) == 0 {
+			w.WriteHeader(http.StatusBadRequest)
+		}
+	}))
+	defer server.Close()
+
+	outbox := &outboxStub{events: make(map[string]blockatlas.OutboxEvent)}
+	d := &Dispatcher{
+		Outbox:      outbox,
+		MaxAttempts: 2,
+		Webhooks:    webhooksStub{"guid": {GUID: "guid", URL: server.URL}},
+		Secret:      "default",
+	}
+	body := []byte(`{"guid":"guid"}`)
+	event := blockatlas.OutboxEvent{ID: OutboxID(body), GUID: "guid", Body: body}
+	assert.Nil(t, outbox.SaveOutboxEvent(event))
+
+	// A failed delivery stays in the outbox
+	d.deliverOutbox(event, nil)
+	events, _ := outbox.GetOutboxEvents()
+	assert.Len(t, events, 1)
+	assert.Equal(t, 1, events[0].Attempts)
+	assert.False(t, events[0].Dead)
+	assert.NotEmpty(t, events[0].Error)
+
+	// The event is redelivered once the last attempt is old enough
+	d.redeliver(time.Unix(events[0].Updated-1, 0))
+	events, _ = outbox.GetOutboxEvents()
+	assert.Equal(t, 1, events[0].Attempts)
+
+	// and becomes dead after MaxAttempts
+	d.redeliver(time.Now().Add(time.Minute))
+	dead, err := DeadLetters(outbox)
+	assert.Nil(t, err)
+	assert.Len(t, dead, 1)
+	assert.Equal(t, 2, dead[0].Attempts)
+
+	// Dead letters are not redelivered until they are replayed
+	atomic.StoreInt32(&healthy, 1)
+	d.redeliver(time.Now().Add(time.Minute))
+	dead, _ = DeadLetters(outbox)
+	assert.Len(t, dead, 1)
+
+	count, err := ReplayDeadLetters(outbox, "unknown")
+	assert.Nil(t, err)
+	assert.Equal(t, 0, count)
+	count, err = ReplayDeadLetters(outbox)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, count)
+
+	d.redeliver(time.Now())
+	events, _ = outbox.GetOutboxEvents()
+	assert.Len(t, events, 0)
+}
+
+func TestOutboxID(t *testing.T) {
+	assert.Equal(t, OutboxID([]byte("a")), OutboxID([]byte("a")))
+	assert.NotEqual(t, OutboxID([]byte("a")), OutboxID([]byte("b")))
+	assert.Len(t, OutboxID([]byte("a")), 64)
+}
@@ -0,0 +1,75 @@
+package observer
+
+import (
+	"github.com/trustwallet/blockatlas/pkg/blockatlas"
+	"sync"
+)
+
+// blockWindow keeps the most recent blocks of a chain
+// to detect reorganizations and find the orphaned transactions
+type blockWindow struct {
+	size   int64
+	blocks map[int64]*blockatlas.Block
+	lock   sync.RWMutex
+}
+
+func newBlockWindow(size int) *blockWindow {
+	return &blockWindow{
+		size:   int64(size),
+		blocks: make(map[int64]*blockatlas.Block),
+	}
+}
+
+// add stores the block and evicts the blocks which fell out of the window
+func (w *blockWindow) add(block *blockatlas.Block) {
+	w.lock.Lock()
+	defer w.lock.Unlock()
+	w.blocks[block.Number] = block
+	for num := range w.blocks {
+		if num <= block.Number-w.size || num > block.Number {
+			delete(w.blocks, num)
+		}
+	}
+}
+
+func (w *blockWindow) get(num int64) (*blockatlas.Block, bool) {
+	w.lock.RLock()
+	defer w.lock.RUnlock()
+	b, ok := w.blocks[num]
+	return b, ok
+}
+
+// forks reports whether the block doesn't extend the known parent block
+func (w *blockWindow) forks(block *blockatlas.Block) bool {
+	if block.ParentID == "" {
+		return false
+	}
+	parent, ok := w.get(block.Number - 1)
+	if !ok || parent.ID == "" {
+		return false
+	}
+	return parent.ID != block.ParentID
+}
+
+// txIDs returns the IDs of the transactions of the blocks
+func txIDs(blocks []*blockatlas.Block) map[string]bool {
+	ids := make(map[string]bool)
+	for _, b := range blocks {
+		for _, tx := range b.Txs {
+			ids[tx.ID] = true
+		}
+	}
+	return ids
+}
+
+// diffTxs returns the transactions whose ID is not part of ids
+func diffTxs(txs []blockatlas.Tx, ids map[string]bool) []blockatlas.Tx {
+	result := make([]blockatlas.Tx, 0)
+	for _, tx := range txs {
+		if ids[tx.ID] {
+			continue
+		}
+		result = append(result, tx)
+	}
+	return result
+}
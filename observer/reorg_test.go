@@ -0,0 +1,101 @@
+package observer
+
+import (
+	"context"
+	"github.com/stretchr/testify/assert"
+	"github.com/trustwallet/blockatlas/coin"
+	"github.com/trustwallet/blockatlas/pkg/blockatlas"
+	"testing"
+)
+
+type chainAPI struct {
+	blocks map[int64]*blockatlas.Block
+}
+
+func (c *chainAPI) Coin() coin.Coin {
+	return coin.Coins[coin.BTC]
+}
+
+func (c *chainAPI) CurrentBlockNumber() (int64, error) {
+	return int64(len(c.blocks)), nil
+}
+
+func (c *chainAPI) GetBlockByNumber(num int64) (*blockatlas.Block, error) {
+	return c.blocks[num], nil
+}
+
+func TestBlockWindow(t *testing.T) {
+	w := newBlockWindow(2)
+	w.add(&blockatlas.Block{Number: 1, ID: "a"})
+	w.add(&blockatlas.Block{Number: 2, ID: "b"})
+	w.add(&blockatlas.Block{Number: 3, ID: "c"})
+
+	_, ok := w.get(1)
+	assert.False(t, ok)
+	_, ok = w.get(2)
+	assert.True(t, ok)
+
+	assert.False(t, w.forks(&blockatlas.Block{Number: 4, ParentID: "c"}))
+	assert.True(t, w.forks(&blockatlas.Block{Number: 4, ParentID: "x"}))
+	assert.False(t, w.forks(&blockatlas.Block{Number: 4}))
+	assert.False(t, w.forks(&blockatlas.Block{Number: 5, ParentID: "x"}))
+
+	// Replacing a block drops the blocks built on top of it
+	w.add(&blockatlas.Block{Number: 2, ID: "b2"})
+	_, ok = w.get(3)
+	assert.False(t, ok)
+}
+
+func TestStream_emitReorg(t *testing.T) {
+	kept := blockatlas.Tx{ID: "kept"}
+	orphaned := blockatlas.Tx{ID: "orphaned"}
+	added := blockatlas.Tx{ID: "added"}
+	// moved is mined again in the next block of the canonical branch
+	moved := blockatlas.Tx{ID: "moved"}
+	next := blockatlas.Tx{ID: "next"}
+
+	api := &chainAPI{blocks: map[int64]*blockatlas.Block{
+		10: {Number: 10, ID: "10", ParentID: "9"},
+		11: {Number: 11, ID: "11b", ParentID: "10", Txs: []blockatlas.Tx{kept, added}},
+		12: {Number: 12, ID: "12b", ParentID: "11b", Txs: []blockatlas.Tx{moved, next}},
+	}}
+	s := Stream{BlockAPI: api, window: newBlockWindow(5)}
+
+	c := make(chan *blockatlas.Block, 10)
+	s.emit(context.Background(), c, &blockatlas.Block{Number: 10, ID: "10", ParentID: "9"})
+	s.emit(context.Background(), c, &blockatlas.Block{Number: 11, ID: "11a", ParentID: "10", Txs: []blockatlas.Tx{kept, orphaned, moved}})
+	s.emit(context.Background(), c, api.blocks[12])
+	close(c)
+
+	var emitted []*blockatlas.Block
+	for b := range c {
+		emitted = append(emitted, b)
+	}
+	assert.Len(t, emitted, 4)
+
+	rolledBack := emitted[2]
+	assert.Equal(t, "11b", rolledBack.ID)
+	assert.Equal(t, []blockatlas.Tx{added}, rolledBack.Txs)
+	assert.Equal(t, []blockatlas.Tx{orphaned}, rolledBack.RevertedTxs)
+	assert.Equal(t, "12b", emitted[3].ID)
+	assert.Equal(t, []blockatlas.Tx{next}, emitted[3].Txs)
+
+	current, ok := s.window.get(11)
+	assert.True(t, ok)
+	assert.Len(t, current.Txs, 2)
+	current, ok = s.window.get(12)
+	assert.True(t, ok)
+	assert.Len(t, current.Txs, 2)
+}
+
+func TestStream_emit_canceled(t *testing.T) {
+	s := Stream{window: newBlockWindow(5)}
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	// Nobody reads the channel, the canceled context stops the send
+	c := make(chan *blockatlas.Block)
+	s.emit(ctx, c, &blockatlas.Block{Number: 10, ID: "10"})
+	_, ok := s.window.get(10)
+	assert.True(t, ok)
+}
@@ -1,6 +1,7 @@
 package observer
 
 import (
+	"context"
 	"github.com/trustwallet/blockatlas/pkg/blockatlas"
 	"github.com/trustwallet/blockatlas/pkg/logger"
 	"math/rand"
@@ -13,13 +14,17 @@ type stop struct {
 	error
 }
 
-func retry(attempts int, sleep time.Duration, f GetBlockByNumber, n int64) (*blockatlas.Block, error) {
+// retry calls f until it succeeds, returns a stop error or the attempts are exhausted,
+// the wait between the attempts is cut short when the context is done.
+// The retries are counted by the handle of the platform
+func retry(ctx context.Context, handle string, attempts int, sleep time.Duration, f GetBlockByNumber, n int64) (*blockatlas.Block, error) {
 	r, err := f(n)
 	if err != nil {
 		if s, ok := err.(stop); ok {
 			return nil, s.error
 		}
 		if attempts--; attempts > 0 {
+			blockRetries.WithLabelValues(handle).Inc()
 			// Add some randomness to prevent creating a Thundering Herd
 			jitter := time.Duration(rand.Int63n(int64(sleep)))
 			sleep = sleep + jitter/2
@@ -32,8 +37,12 @@ func retry(attempts int, sleep time.Duration, f GetBlockByNumber, n int64) (*blo
 				},
 			)
 
-			time.Sleep(sleep)
-			return retry(attempts, sleep*2, f, n)
+			select {
+			case <-ctx.Done():
+				return nil, err
+			case <-time.After(sleep):
+			}
+			return retry(ctx, handle, attempts, sleep*2, f, n)
 		}
 	}
 	return r, err
@@ -1,6 +1,7 @@
 package observer
 
 import (
+	"context"
 	"errors"
 	"github.com/trustwallet/blockatlas/pkg/blockatlas"
 	"testing"
@@ -15,7 +16,7 @@ func getBlock(num int64) (*blockatlas.Block, error) {
 }
 
 func TestRetry(t *testing.T) {
-	block, err := retry(3, time.Second*1, getBlock, 1)
+	block, err := retry(context.Background(), "bitcoin", 3, time.Second*1, getBlock, 1)
 	if err != nil {
 		t.Error(err)
 	}
@@ -27,7 +28,7 @@ func TestRetry(t *testing.T) {
 
 func TestRetryError(t *testing.T) {
 	now := time.Now()
-	block, err := retry(3, time.Second*1, getBlock, 0)
+	block, err := retry(context.Background(), "bitcoin", 3, time.Second*1, getBlock, 0)
 	elapsed := time.Since(now)
 	if err == nil {
 		t.Error("retry method need fail")
@@ -41,3 +42,17 @@ func TestRetryError(t *testing.T) {
 		t.Error("Thundering Herd prevent doesn't work")
 	}
 }
+
+func TestRetryCancel(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	now := time.Now()
+	_, err := retry(ctx, "bitcoin", 3, time.Second*1, getBlock, 0)
+	if err == nil {
+		t.Error("retry method need fail")
+	}
+
+	if time.Since(now) > time.Millisecond*500 {
+		t.Error("retry didn't stop when the context is done")
+	}
+}
@@ -4,11 +4,11 @@ import (
 	"context"
 	"github.com/spf13/viper"
 	"github.com/trustwallet/blockatlas/pkg/blockatlas"
+	"github.com/trustwallet/blockatlas/pkg/errors"
 	"github.com/trustwallet/blockatlas/pkg/logger"
 	"github.com/trustwallet/blockatlas/pkg/semaphore"
 	"github.com/trustwallet/blockatlas/storage"
 	"sync"
-	"sync/atomic"
 	"time"
 )
 
@@ -17,29 +17,69 @@ type Stream struct {
 	Tracker      storage.Tracker
 	PollInterval time.Duration
 	BacklogCount int
-	coin         uint
-	logParams    logger.Params
+	// Number of recent blocks kept to detect chain reorganizations, 0 disables the detection
+	ReorgWindow int
+	coin        uint
+	handle      string
+	logParams   logger.Params
+	window      *blockWindow
 
 	// Concurrency
-	blockNumber int64
-	semaphore   *semaphore.Semaphore
-	wg          sync.WaitGroup
+	semaphore *semaphore.Semaphore
+	wg        sync.WaitGroup
 }
 
 func (s *Stream) Execute(ctx context.Context) <-chan *blockatlas.Block {
-	cn := s.BlockAPI.Coin()
-	s.coin = cn.ID
-	s.logParams = logger.Params{"platform": cn.Handle}
 	conns := viper.GetInt("observer.stream_conns")
 	if conns == 0 {
 		logger.Fatal("observer.stream_conns is 0")
 	}
-	s.semaphore = semaphore.NewSemaphore(conns)
+	s.init(conns)
+	if s.ReorgWindow > 0 {
+		s.window = newBlockWindow(s.ReorgWindow)
+	}
 	c := make(chan *blockatlas.Block)
 	go s.run(ctx, c)
 	return c
 }
 
+func (s *Stream) init(conns int) {
+	cn := s.BlockAPI.Coin()
+	s.coin = cn.ID
+	s.handle = cn.Handle
+	s.logParams = logger.Params{"platform": cn.Handle}
+	s.semaphore = semaphore.NewSemaphore(conns)
+}
+
+// Replay streams the blocks of the height range in order with the given concurrency,
+// the tracker and the reorg window are not used. The stream stops at the first block which
+// can't be fetched, the error is logged with the height to resume from
+func (s *Stream) Replay(ctx context.Context, from, to int64, conns int) <-chan *blockatlas.Block {
+	s.init(conns)
+	c := make(chan *blockatlas.Block)
+	go func() {
+		defer close(c)
+		for first := from; first <= to; first += int64(conns) {
+			last := first + int64(conns) - 1
+			if last > to {
+				last = to
+			}
+			for i, block := range s.fetch(ctx, first, last) {
+				if block == nil {
+					logger.Error("Replay stopped: could not get block", s.logParams, logger.Params{"resume_from": first + int64(i)})
+					return
+				}
+				select {
+				case <-ctx.Done():
+					return
+				case c <- block:
+				}
+			}
+		}
+	}()
+	return c
+}
+
 func (s *Stream) run(ctx context.Context, c chan<- *blockatlas.Block) {
 	ticker := time.NewTicker(s.PollInterval)
 	for {
@@ -49,24 +89,29 @@ func (s *Stream) run(ctx context.Context, c chan<- *blockatlas.Block) {
 			close(c)
 			return
 		case <-ticker.C:
-			s.load(c)
+			s.load(ctx, c)
 		}
 	}
 }
 
-func (s *Stream) load(c chan<- *blockatlas.Block) {
+func (s *Stream) load(ctx context.Context, c chan<- *blockatlas.Block) {
 	lastHeight, err := s.Tracker.GetBlockNumber(s.coin)
 	if err != nil {
 		logger.Error(err, "Polling failed: tracker didn't return last known block number", s.logParams)
 		return
 	}
 
-	height, err := s.BlockAPI.CurrentBlockNumber()
-	height -= s.BlockAPI.Coin().MinConfirmations
+	height, err := blockatlas.BlockAPIContext(s.BlockAPI).CurrentBlockNumberWithContext(ctx)
 	if err != nil {
+		if ctx.Err() != nil {
+			return
+		}
 		logger.Error(err, "Polling failed: source didn't return chain head number", s.logParams)
 		return
 	}
+	height -= s.BlockAPI.Coin().MinConfirmations
+
+	blockLag.WithLabelValues(s.handle).Set(float64(height - lastHeight))
 
 	if height-lastHeight > int64(s.BacklogCount) {
 		lastHeight = height - int64(s.BacklogCount)
@@ -76,32 +121,139 @@ func (s *Stream) load(c chan<- *blockatlas.Block) {
 		lastHeight = height - backLogMax
 	}
 
-	atomic.StoreInt64(&s.blockNumber, lastHeight)
-	for i := lastHeight + 1; i <= height; i++ {
+	if height <= lastHeight {
+		return
+	}
+
+	blocks := s.fetch(ctx, lastHeight+1, height)
+
+	// Blocks are emitted in height order, so every block can be checked against its parent.
+	// The checkpoint only moves past contiguous blocks: the first failed height stops the batch
+	// and the next tick starts from it again, the blocks after it are fetched again as well
+	for i, block := range blocks {
+		num := lastHeight + 1 + int64(i)
+		if block == nil {
+			logger.Warn("Block will be retried on the next poll", s.logParams, logger.Params{"block": num, "dropped": len(blocks) - i - 1})
+			return
+		}
+		s.emit(ctx, c, block)
+		logger.Info("Got new block", s.logParams, logger.Params{"block": num, "txs": len(block.Txs)})
+
+		err = s.Tracker.SetBlockNumber(s.coin, num)
+		if err != nil {
+			logger.Error(err, "SetBlockNumber failed", s.logParams, logger.Params{"block": num, "coin": s.coin})
+		}
+	}
+}
+
+// fetch loads the blocks of the height range concurrently, the blocks which failed are nil
+func (s *Stream) fetch(ctx context.Context, first, last int64) []*blockatlas.Block {
+	blocks := make([]*blockatlas.Block, last-first+1)
+	for i := first; i <= last; i++ {
 		s.wg.Add(1)
-		go s.loadBlock(c, i)
+		go s.loadBlock(ctx, blocks, first, i)
 	}
 	s.wg.Wait()
+	return blocks
 }
 
-func (s *Stream) loadBlock(c chan<- *blockatlas.Block, num int64) {
+func (s *Stream) loadBlock(ctx context.Context, blocks []*blockatlas.Block, first, num int64) {
 	defer s.wg.Done()
 	s.semaphore.Acquire()
 	defer s.semaphore.Release()
 
-	block, err := retry(5, time.Second*5, s.BlockAPI.GetBlockByNumber, num)
+	block, err := retry(ctx, s.handle, 5, time.Second*5, s.getBlockByNumber(ctx), num)
+	if ctx.Err() != nil {
+		return
+	}
+	if err == nil && block == nil {
+		err = errors.E("platform returned no block", errors.Params{"block": num})
+	}
 	if err != nil {
+		blocksFetched.WithLabelValues(s.handle, "error").Inc()
 		logger.Error(err, "Polling failed: could not get block", s.logParams, logger.Params{"block": num})
 		return
 	}
-	c <- block
-	logger.Info(err, "Got new block", s.logParams, logger.Params{"block": num, "txs": len(block.Txs)})
+	blocksFetched.WithLabelValues(s.handle, "ok").Inc()
+	blocks[num-first] = block
+}
 
-	// Not strictly correct nor avoids race conditions
-	// But good enough
-	newNum := atomic.AddInt64(&s.blockNumber, 1)
-	err = s.Tracker.SetBlockNumber(s.coin, newNum)
-	if err != nil {
-		logger.Error(err, "SetBlockNumber failed", s.logParams, logger.Params{"block": num, "coin": s.coin})
+// getBlockByNumber fetches the blocks with the context, the retries stop once the context is done
+func (s *Stream) getBlockByNumber(ctx context.Context) GetBlockByNumber {
+	api := blockatlas.BlockAPIContext(s.BlockAPI)
+	return func(num int64) (*blockatlas.Block, error) {
+		block, err := api.GetBlockByNumberWithContext(ctx, num)
+		if err != nil && ctx.Err() != nil {
+			return nil, stop{ctx.Err()}
+		}
+		return block, err
+	}
+}
+
+// emit sends the block to the channel. If the block doesn't extend the known chain,
+// the canonical branch is sent first along with the transactions of the orphaned blocks.
+func (s *Stream) emit(ctx context.Context, c chan<- *blockatlas.Block, block *blockatlas.Block) {
+	blocks := []*blockatlas.Block{block}
+	if s.window != nil {
+		if s.window.forks(block) {
+			logger.Warn("Chain reorganization detected", s.logParams, logger.Params{"block": block.Number, "parent": block.ParentID})
+			blocks = s.rollback(ctx, block)
+		}
+		s.window.add(block)
+	}
+	for _, b := range blocks {
+		select {
+		case <-ctx.Done():
+			return
+		case c <- b:
+		}
+	}
+}
+
+// rollback re-fetches the blocks replaced by a reorganization, walking back from the block
+// until its branch joins the known chain. The returned blocks are ordered by height and end
+// with the block itself. They only contain the transactions which are new in the canonical branch,
+// the transactions which disappeared with the orphaned blocks are set as reverted. The branches
+// are compared as a whole, a transaction mined again at another height is neither.
+func (s *Stream) rollback(ctx context.Context, block *blockatlas.Block) []*blockatlas.Block {
+	canonical := make([]*blockatlas.Block, 0)
+	orphaned := make([]*blockatlas.Block, 0)
+	parentID := block.ParentID
+	for num := block.Number - 1; parentID != ""; num-- {
+		known, ok := s.window.get(num)
+		if !ok {
+			logger.Error("Chain reorganization is deeper than the tracked window", s.logParams, logger.Params{"block": num})
+			break
+		}
+		if known.ID == parentID {
+			break
+		}
+		b, err := retry(ctx, s.handle, 5, time.Second*5, s.getBlockByNumber(ctx), num)
+		if err != nil {
+			logger.Error(err, "Rollback failed: could not get canonical block", s.logParams, logger.Params{"block": num})
+			break
+		}
+		canonical = append([]*blockatlas.Block{b}, canonical...)
+		orphaned = append([]*blockatlas.Block{known}, orphaned...)
+		parentID = b.ParentID
+	}
+
+	orphanedIDs := txIDs(orphaned)
+	canonicalIDs := txIDs(append([]*blockatlas.Block{block}, canonical...))
+	result := make([]*blockatlas.Block, 0, len(canonical)+1)
+	for i, b := range canonical {
+		s.window.add(b)
+		diff := *b
+		diff.Txs = diffTxs(b.Txs, orphanedIDs)
+		diff.RevertedTxs = diffTxs(orphaned[i].Txs, canonicalIDs)
+		result = append(result, &diff)
+		logger.Info("Reverted orphaned block", s.logParams, logger.Params{
+			"block":    b.Number,
+			"orphaned": orphaned[i].ID,
+			"reverted": len(diff.RevertedTxs),
+		})
 	}
+	tip := *block
+	tip.Txs = diffTxs(block.Txs, orphanedIDs)
+	return append(result, &tip)
 }
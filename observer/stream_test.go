@@ -0,0 +1,116 @@
+package observer
+
+import (
+	"context"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+	"github.com/trustwallet/blockatlas/coin"
+	"github.com/trustwallet/blockatlas/pkg/blockatlas"
+	"github.com/trustwallet/blockatlas/pkg/semaphore"
+	"sync"
+	"testing"
+)
+
+type trackerStub struct {
+	sync.Mutex
+	heights map[uint]int64
+}
+
+func (t *trackerStub) GetBlockNumber(coin uint) (int64, error) {
+	t.Lock()
+	defer t.Unlock()
+	return t.heights[coin], nil
+}
+
+func (t *trackerStub) SetBlockNumber(coin uint, num int64) error {
+	t.Lock()
+	defer t.Unlock()
+	t.heights[coin] = num
+	return nil
+}
+
+// gapAPI serves the blocks up to head, the missing ones fail
+type gapAPI struct {
+	sync.Mutex
+	head   int64
+	blocks map[int64]*blockatlas.Block
+}
+
+func (g *gapAPI) Coin() coin.Coin {
+	return coin.Coins[coin.BTC]
+}
+
+func (g *gapAPI) CurrentBlockNumber() (int64, error) {
+	return g.head, nil
+}
+
+func (g *gapAPI) GetBlockByNumber(num int64) (*blockatlas.Block, error) {
+	g.Lock()
+	defer g.Unlock()
+	return g.blocks[num], nil
+}
+
+func TestStream_load(t *testing.T) {
+	viper.Set("observer.backlog_max_blocks", 100)
+	defer viper.Set("observer.backlog_max_blocks", nil)
+
+	api := &gapAPI{head: 5, blocks: map[int64]*blockatlas.Block{
+		1: {Number: 1},
+		2: {Number: 2},
+		4: {Number: 4},
+		5: {Number: 5},
+	}}
+	tracker := &trackerStub{heights: map[uint]int64{coin.BTC: 0}}
+	s := Stream{
+		BlockAPI:     api,
+		Tracker:      tracker,
+		BacklogCount: 100,
+		coin:         coin.BTC,
+		semaphore:    semaphore.NewSemaphore(4),
+	}
+
+	// The failed block stops the batch, the checkpoint stays before it
+	c := make(chan *blockatlas.Block, 10)
+	s.load(context.Background(), c)
+	assert.Equal(t, []int64{1, 2}, blockNumbers(c))
+	height, _ := tracker.GetBlockNumber(coin.BTC)
+	assert.Equal(t, int64(2), height)
+
+	// and the next poll starts from the failed height
+	api.Lock()
+	api.blocks[3] = &blockatlas.Block{Number: 3}
+	api.Unlock()
+	s.load(context.Background(), c)
+	assert.Equal(t, []int64{3, 4, 5}, blockNumbers(c))
+	height, _ = tracker.GetBlockNumber(coin.BTC)
+	assert.Equal(t, int64(5), height)
+}
+
+func blockNumbers(c chan *blockatlas.Block) []int64 {
+	numbers := make([]int64, 0)
+	for len(c) > 0 {
+		numbers = append(numbers, (<-c).Number)
+	}
+	return numbers
+}
+
+func TestStream_Replay(t *testing.T) {
+	api := &gapAPI{head: 10, blocks: map[int64]*blockatlas.Block{
+		3: {Number: 3},
+		4: {Number: 4},
+		5: {Number: 5},
+		6: {Number: 6},
+		8: {Number: 8},
+	}}
+	tracker := &trackerStub{heights: map[uint]int64{coin.BTC: 7}}
+	s := Stream{BlockAPI: api, Tracker: tracker}
+
+	numbers := make([]int64, 0)
+	for block := range s.Replay(context.Background(), 3, 7, 2) {
+		numbers = append(numbers, block.Number)
+	}
+	// Stops at the missing block 7, the tracker is left as is
+	assert.Equal(t, []int64{3, 4, 5, 6}, numbers)
+	height, _ := tracker.GetBlockNumber(coin.BTC)
+	assert.Equal(t, int64(7), height)
+}
@@ -0,0 +1,91 @@
+package observer
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"github.com/cenkalti/backoff"
+	"github.com/trustwallet/blockatlas/pkg/errors"
+	"github.com/trustwallet/blockatlas/pkg/logger"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"time"
+)
+
+const (
+	// SignatureHeader carries the hex encoded HMAC-SHA256 of the webhook body
+	SignatureHeader = "X-Blockatlas-Signature"
+	signaturePrefix = "sha256="
+)
+
+// Sign returns the SignatureHeader value of the body
+func Sign(secret string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(body)
+	return signaturePrefix + hex.EncodeToString(mac.Sum(nil))
+}
+
+// VerifySignature checks the SignatureHeader value of a webhook body
+func VerifySignature(secret string, body []byte, signature string) bool {
+	return hmac.Equal([]byte(Sign(secret, body)), []byte(signature))
+}
+
+func (d *Dispatcher) postWebhook(guid string, rawMessage []byte, logParams logger.Params) error {
+	webhook, err := d.Webhooks.GetWebhook(guid)
+	if err != nil {
+		return errors.E(err, "no webhook for the guid", errors.Params{"guid": guid})
+	}
+	secret := webhook.Secret
+	if len(secret) == 0 {
+		secret = d.Secret
+	}
+	if len(secret) == 0 {
+		return errors.E("no webhook secret to sign the message", errors.Params{"guid": guid})
+	}
+
+	b := backoff.NewExponentialBackOff()
+	if d.MaxElapsedTime > 0 {
+		b.MaxElapsedTime = d.MaxElapsedTime
+	}
+	return d.deliver(webhook.URL, secret, rawMessage, b, logParams)
+}
+
+// deliver posts the body until it succeeds, fails permanently or the backoff gives up
+func (d *Dispatcher) deliver(url, secret string, body []byte, b backoff.BackOff, logParams logger.Params) error {
+	notify := func(err error, next time.Duration) {
+		logger.Warn("Webhook delivery failed, retrying", logger.Params{"next": next, "err": err.Error()}, logParams)
+	}
+	return backoff.RetryNotify(func() error {
+		return d.post(url, secret, body)
+	}, b, notify)
+}
+
+func (d *Dispatcher) post(url, secret string, body []byte) error {
+	req, err := http.NewRequest(http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		return backoff.Permanent(errors.E(err, errors.Params{"url": url}))
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(SignatureHeader, Sign(secret, body))
+
+	res, err := d.Client.Do(req)
+	if err != nil {
+		return errors.E(err, errors.Params{"url": url})
+	}
+	defer res.Body.Close()
+	_, _ = io.Copy(ioutil.Discard, res.Body)
+
+	if res.StatusCode >= 200 && res.StatusCode < 300 {
+		return nil
+	}
+	err = errors.E(fmt.Sprintf("webhook responded with status %d", res.StatusCode), errors.Params{"url": url})
+	// The client errors won't go away by retrying, except for timeouts and rate limits
+	if res.StatusCode >= 400 && res.StatusCode < 500 &&
+		res.StatusCode != http.StatusRequestTimeout && res.StatusCode != http.StatusTooManyRequests {
+		return backoff.Permanent(err)
+	}
+	return err
+}
@@ -0,0 +1,108 @@
+package observer
+
+import (
+	"github.com/cenkalti/backoff"
+	"github.com/stretchr/testify/assert"
+	"github.com/trustwallet/blockatlas/pkg/blockatlas"
+	"github.com/trustwallet/blockatlas/pkg/errors"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+type webhooksStub map[string]blockatlas.Webhook
+
+func (w webhooksStub) GetWebhook(guid string) (*blockatlas.Webhook, error) {
+	webhook, ok := w[guid]
+	if !ok {
+		return nil, errors.E("webhook not found")
+	}
+	return &webhook, nil
+}
+
+func (w webhooksStub) SetWebhook(webhook blockatlas.Webhook) error {
+	w[webhook.GUID] = webhook
+	return nil
+}
+
+func (w webhooksStub) DeleteWebhook(guid string) error {
+	delete(w, guid)
+	return nil
+}
+
+func TestSign(t *testing.T) {
+	body := []byte(`{"guid":"guid"}`)
+	signature := Sign("secret", body)
+	assert.Equal(t, "sha256=", signature[:7])
+	assert.Len(t, signature, 7+64)
+	assert.True(t, VerifySignature("secret", body, signature))
+	assert.False(t, VerifySignature("other", body, signature))
+	assert.False(t, VerifySignature("secret", []byte(`{"guid":"other"}`), signature))
+}
+
+func TestDispatcher_post(t *testing.T) {
+	body := []byte(`{"guid":"guid"}`)
+	tests := []struct {
+		name    string
+		status  int
+		wantErr bool
+		wantTry int32
+	}{
+		{"delivered", http.StatusOK, false, 1},
+		{"server error is retried", http.StatusInternalServerError, true, 3},
+		{"rate limit is retried", http.StatusTooManyRequests, true, 3},
+		{"client error is permanent", http.StatusBadRequest, true, 1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var tries int32
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				atomic.AddInt32(&tries, 1)
+				got, _ := ioutil.ReadAll(r.Body)
+				assert.Equal(t, body, got)
+				assert.True(t, VerifySignature("secret", got, r.Header.Get(SignatureHeader)))
+				assert.Equal(t, "application/json", r.Header.Get("Content-Type"))
+				w.WriteHeader(tt.status)
+			}))
+			defer server.Close()
+
+			d := Dispatcher{}
+			err := d.deliver(server.URL, "secret", body, backoff.WithMaxRetries(&backoff.ZeroBackOff{}, 2), nil)
+			assert.Equal(t, tt.wantErr, err != nil)
+			assert.Equal(t, tt.wantTry, atomic.LoadInt32(&tries))
+		})
+	}
+}
+
+func TestDispatcher_postWebhook(t *testing.T) {
+	received := make(chan string, 1)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := ioutil.ReadAll(r.Body)
+		if VerifySignature("default", body, r.Header.Get(SignatureHeader)) {
+			received <- string(body)
+		}
+	}))
+	defer server.Close()
+
+	d := Dispatcher{
+		Webhooks: webhooksStub{"guid": {GUID: "guid", URL: server.URL}},
+		Secret:   "default",
+	}
+	assert.Nil(t, d.postWebhook("guid", []byte(`{"guid":"guid"}`), nil))
+	assert.NotNil(t, d.postWebhook("unknown", []byte(`{"guid":"unknown"}`), nil))
+
+	// The messages are never posted unsigned
+	unsigned := Dispatcher{Webhooks: d.Webhooks}
+	assert.NotNil(t, unsigned.postWebhook("guid", []byte(`{"guid":"guid"}`), nil))
+
+	select {
+	case body := <-received:
+		assert.Equal(t, `{"guid":"guid"}`, body)
+	case <-time.After(time.Second):
+		t.Fatal("webhook was not delivered")
+	}
+	assert.Len(t, received, 0)
+}
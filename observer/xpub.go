@@ -0,0 +1,50 @@
+package observer
+
+import (
+	"github.com/trustwallet/blockatlas/pkg/blockatlas"
+	"github.com/trustwallet/blockatlas/pkg/logger"
+	"github.com/trustwallet/blockatlas/services/subscription"
+	"sync"
+)
+
+// XpubExtender moves the gap limit window of the subscribed xpubs forward:
+// when a derived address has a transaction, the xpub is derived again and its GUIDs are
+// subscribed to the new addresses
+type XpubExtender struct {
+	API     blockatlas.AddressAPI
+	Storage subscription.XpubStorage
+	running sync.Map
+	wg      sync.WaitGroup
+}
+
+// Extend derives the xpubs of the addresses in the background, an xpub is derived once at a time
+func (x *XpubExtender) Extend(coin uint, addresses []string) {
+	xpubs, err := x.Storage.LookupXpubs(coin, addresses)
+	if err != nil {
+		logger.Error(err, "Failed to look up xpubs", logger.Params{"coin": coin})
+		return
+	}
+	for _, xpub := range xpubs {
+		if _, loaded := x.running.LoadOrStore(xpub, struct{}{}); loaded {
+			continue
+		}
+		x.wg.Add(1)
+		go func(xpub string) {
+			defer x.wg.Done()
+			defer x.running.Delete(xpub)
+			added, err := subscription.ExtendXpub(x.Storage, x.API, coin, xpub)
+			if err != nil {
+				logger.Error(err, "Failed to extend xpub addresses", logger.Params{"coin": coin})
+				return
+			}
+			if len(added) > 0 {
+				logger.Info("Extended xpub addresses", logger.Params{"coin": coin, "added": len(added)})
+			}
+		}(xpub)
+	}
+}
+
+// Wait blocks until the derivations in progress are finished
+func (x *XpubExtender) Wait() {
+	x.wg.Wait()
+}
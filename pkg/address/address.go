@@ -57,3 +57,21 @@ func HexToAddress(hexAddr string) (b58 string, err error) {
 	b58 = base58.EncodeAlphabet(bytes, base58.BTCAlphabet)
 	return
 }
+
+// IsExtendedPubKey checks if the key is a Base58Check serialized BIP32 extended public key
+// of any version (xpub, ypub, zpub, Ltub...)
+func IsExtendedPubKey(key string) bool {
+	b, err := base58.DecodeAlphabet(key, base58.BTCAlphabet)
+	// 4 version, 1 depth, 4 fingerprint, 4 child number, 32 chain code, 33 key and 4 checksum bytes
+	if err != nil || len(b) != 82 {
+		return false
+	}
+	payload, checksum := b[:78], b[78:]
+	hash := sha256.Sum256(payload)
+	hash = sha256.Sum256(hash[:])
+	if string(hash[:4]) != string(checksum) {
+		return false
+	}
+	// The private keys are prefixed with a zero byte instead of the compressed public key prefix
+	return payload[45] == 0x02 || payload[45] == 0x03
+}
@@ -0,0 +1,393 @@
+package address
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"crypto/sha512"
+	"encoding/base32"
+	"encoding/hex"
+	"github.com/btcsuite/btcutil/bech32"
+	"github.com/mr-tron/base58"
+	"github.com/trustwallet/blockatlas/pkg/errors"
+	"golang.org/x/crypto/blake2b"
+	"golang.org/x/crypto/ripemd160"
+	"golang.org/x/crypto/sha3"
+	"math/big"
+	"strings"
+)
+
+var (
+	rippleAlphabet = base58.NewAlphabet("rpshnaf39wBUDNEGHJKLM4PQRST7VWXYZ2bcdeCg65jkm8oFqi1tuvAxyz")
+	strKeyEncoding = base32.StdEncoding.WithPadding(base32.NoPadding)
+
+	errFormat   = errors.E("invalid format")
+	errChecksum = errors.E("invalid checksum")
+)
+
+const (
+	cashAddrPrefix = "bitcoincash:"
+	cashAddrChars  = "qpzry9x8gf2tvdw0s3jn54khce6mua7l"
+	nanoChars      = "13456789abcdefghijkmnopqrstuwxyz"
+	nimiqChars     = "0123456789ABCDEFGHJKLMNPQRSTUVXY"
+	// strKeyAccountID is the version byte of the Stellar public keys (G...)
+	strKeyAccountID = 6 << 3
+)
+
+func isHex(s string, length int) bool {
+	if len(s) != length {
+		return false
+	}
+	_, err := hex.DecodeString(s)
+	return err == nil
+}
+
+// isMixedCase tells if the string has both lower and upper case letters
+func isMixedCase(s string) bool {
+	return s != strings.ToLower(s) && s != strings.ToUpper(s)
+}
+
+func doubleSha256(b []byte) []byte {
+	hash := sha256.Sum256(b)
+	hash = sha256.Sum256(hash[:])
+	return hash[:]
+}
+
+// eip55Address accepts hex addresses in one case or with a valid EIP55 checksum
+func eip55Address(address string) (string, error) {
+	if !strings.HasPrefix(address, "0x") || !isHex(address[2:], ethereumAddressLength) {
+		return "", errFormat
+	}
+	checksummed := EIP55Checksum(address)
+	if isMixedCase(address[2:]) && address != checksummed {
+		return "", errChecksum
+	}
+	return checksummed, nil
+}
+
+// wanchainAddress accepts hex addresses with the Wanchain checksum, which is the EIP55 one with the cases swapped
+func wanchainAddress(address string) (string, error) {
+	if !strings.HasPrefix(address, "0x") || !isHex(address[2:], ethereumAddressLength) {
+		return "", errFormat
+	}
+	checksummed := "0x" + swapCase(EIP55Checksum(address)[2:])
+	if isMixedCase(address[2:]) && address != checksummed {
+		return "", errChecksum
+	}
+	return checksummed, nil
+}
+
+func swapCase(s string) string {
+	return strings.Map(func(r rune) rune {
+		if r >= 'a' && r <= 'z' {
+			return r - 'a' + 'A'
+		}
+		if r >= 'A' && r <= 'Z' {
+			return r - 'A' + 'a'
+		}
+		return r
+	}, s)
+}
+
+func aionAddress(address string) (string, error) {
+	address = strings.ToLower(address)
+	if !strings.HasPrefix(address, "0x") || !isHex(address[2:], 64) {
+		return "", errFormat
+	}
+	return address, nil
+}
+
+func iconAddress(address string) (string, error) {
+	address = strings.ToLower(address)
+	if !strings.HasPrefix(address, "hx") && !strings.HasPrefix(address, "cx") {
+		return "", errFormat
+	}
+	if !isHex(address[2:], 40) {
+		return "", errFormat
+	}
+	return address, nil
+}
+
+// base58Address only checks the decoded length, for the checksums which can't be verified
+func base58Address(length int) normalizer {
+	return func(address string) (string, error) {
+		b, err := base58.DecodeAlphabet(address, base58.BTCAlphabet)
+		if err != nil || len(b) != length {
+			return "", errFormat
+		}
+		return address, nil
+	}
+}
+
+// base58CheckAddress accepts a payload of the given length followed by the double SHA-256 checksum
+func base58CheckAddress(alphabet *base58.Alphabet, length int) normalizer {
+	return func(address string) (string, error) {
+		b, err := base58.DecodeAlphabet(address, alphabet)
+		if err != nil || len(b) != length+4 {
+			return "", errFormat
+		}
+		if !bytes.Equal(doubleSha256(b[:length])[:4], b[length:]) {
+			return "", errChecksum
+		}
+		return address, nil
+	}
+}
+
+func bech32Address(hrps ...string) normalizer {
+	return func(address string) (string, error) {
+		hrp, _, err := bech32.Decode(address)
+		if err != nil {
+			return "", errChecksum
+		}
+		for _, h := range hrps {
+			if hrp == h {
+				return strings.ToLower(address), nil
+			}
+		}
+		return "", errFormat
+	}
+}
+
+// utxoAddress accepts the legacy Base58Check addresses and the segwit addresses of the given prefixes
+func utxoAddress(length int, hrps ...string) normalizer {
+	legacy := base58CheckAddress(base58.BTCAlphabet, length)
+	if len(hrps) == 0 {
+		return legacy
+	}
+	return anyAddress(legacy, bech32Address(hrps...))
+}
+
+// bitcoinCashAddress accepts the CashAddr addresses with or without the prefix and the legacy addresses
+func bitcoinCashAddress(address string) (string, error) {
+	if normalized, err := base58CheckAddress(base58.BTCAlphabet, 21)(address); err == nil {
+		return normalized, nil
+	}
+	if isMixedCase(address) {
+		return "", errFormat
+	}
+	address = strings.TrimPrefix(strings.ToLower(address), cashAddrPrefix)
+	// 34 characters of payload and 8 characters of checksum for the shortest hash
+	if len(address) < 42 {
+		return "", errFormat
+	}
+	values := make([]uint64, 0, len(cashAddrPrefix)+len(address))
+	for _, c := range cashAddrPrefix[:len(cashAddrPrefix)-1] {
+		values = append(values, uint64(c)&0x1f)
+	}
+	values = append(values, 0)
+	for _, c := range address {
+		i := strings.IndexRune(cashAddrChars, c)
+		if i < 0 {
+			return "", errFormat
+		}
+		values = append(values, uint64(i))
+	}
+	if cashAddrPolymod(values) != 0 {
+		return "", errChecksum
+	}
+	return cashAddrPrefix + address, nil
+}
+
+func cashAddrPolymod(values []uint64) uint64 {
+	generators := []uint64{0x98f2bc8e61, 0x79b76d99e2, 0xf33e5fb3c4, 0xae2eabe2a8, 0x1e4f43e470}
+	c := uint64(1)
+	for _, d := range values {
+		c0 := c >> 35
+		c = ((c & 0x07ffffffff) << 5) ^ d
+		for i, g := range generators {
+			if (c0>>uint(i))&1 == 1 {
+				c ^= g
+			}
+		}
+	}
+	return c ^ 1
+}
+
+// aeternityAddress accepts the "ak_" prefixed Base58Check public keys
+func aeternityAddress(address string) (string, error) {
+	if !strings.HasPrefix(address, "ak_") {
+		return "", errFormat
+	}
+	if _, err := base58CheckAddress(base58.BTCAlphabet, 32)(address[3:]); err != nil {
+		return "", err
+	}
+	return address, nil
+}
+
+// fioAddress accepts the "FIO" prefixed public keys with the RIPEMD-160 checksum
+func fioAddress(address string) (string, error) {
+	if !strings.HasPrefix(address, "FIO") {
+		return "", errFormat
+	}
+	b, err := base58.DecodeAlphabet(address[3:], base58.BTCAlphabet)
+	if err != nil || len(b) != 37 {
+		return "", errFormat
+	}
+	hash := ripemd160.New()
+	hash.Write(b[:33])
+	if !bytes.Equal(hash.Sum(nil)[:4], b[33:]) {
+		return "", errChecksum
+	}
+	return address, nil
+}
+
+// nebulasAddress accepts the Base58 addresses with the SHA3-256 checksum
+func nebulasAddress(address string) (string, error) {
+	b, err := base58.DecodeAlphabet(address, base58.BTCAlphabet)
+	if err != nil || len(b) != 26 || b[0] != 0x19 {
+		return "", errFormat
+	}
+	hash := sha3.Sum256(b[:22])
+	if !bytes.Equal(hash[:4], b[22:]) {
+		return "", errChecksum
+	}
+	return address, nil
+}
+
+// wavesAddress accepts the Base58 addresses with the Keccak-256 of BLAKE2b-256 checksum
+func wavesAddress(address string) (string, error) {
+	b, err := base58.DecodeAlphabet(address, base58.BTCAlphabet)
+	if err != nil || len(b) != 26 || b[0] != 1 {
+		return "", errFormat
+	}
+	blake := blake2b.Sum256(b[:22])
+	keccak := sha3.NewLegacyKeccak256()
+	keccak.Write(blake[:])
+	if !bytes.Equal(keccak.Sum(nil)[:4], b[22:]) {
+		return "", errChecksum
+	}
+	return address, nil
+}
+
+// stellarAddress accepts the StrKey encoded public keys
+func stellarAddress(address string) (string, error) {
+	address = strings.ToUpper(address)
+	b, err := strKeyEncoding.DecodeString(address)
+	if err != nil || len(b) != 35 || b[0] != strKeyAccountID {
+		return "", errFormat
+	}
+	crc := crc16XModem(b[:33])
+	if b[33] != byte(crc) || b[34] != byte(crc>>8) {
+		return "", errChecksum
+	}
+	return address, nil
+}
+
+func crc16XModem(data []byte) uint16 {
+	var crc uint16
+	for _, b := range data {
+		crc ^= uint16(b) << 8
+		for i := 0; i < 8; i++ {
+			if crc&0x8000 != 0 {
+				crc = crc<<1 ^ 0x1021
+			} else {
+				crc <<= 1
+			}
+		}
+	}
+	return crc
+}
+
+// algorandAddress accepts the Base32 public keys followed by the last 4 bytes of their SHA-512/256 hash
+func algorandAddress(address string) (string, error) {
+	address = strings.ToUpper(address)
+	b, err := strKeyEncoding.DecodeString(address)
+	if err != nil || len(b) != 36 {
+		return "", errFormat
+	}
+	hash := sha512.Sum512_256(b[:32])
+	if !bytes.Equal(hash[28:], b[32:]) {
+		return "", errChecksum
+	}
+	return address, nil
+}
+
+// nanoAddress accepts the "nano_" and the legacy "xrb_" addresses, the canonical prefix is "nano_"
+func nanoAddress(address string) (string, error) {
+	address = strings.ToLower(address)
+	var encoded string
+	switch {
+	case strings.HasPrefix(address, "nano_"):
+		encoded = address[5:]
+	case strings.HasPrefix(address, "xrb_"):
+		encoded = address[4:]
+	default:
+		return "", errFormat
+	}
+	// 4 padding bits, 256 bits of public key and 40 bits of checksum
+	if len(encoded) != 60 {
+		return "", errFormat
+	}
+	value := new(big.Int)
+	for _, c := range encoded {
+		i := strings.IndexRune(nanoChars, c)
+		if i < 0 {
+			return "", errFormat
+		}
+		value.Lsh(value, 5).Or(value, big.NewInt(int64(i)))
+	}
+	if value.BitLen() > 296 {
+		return "", errFormat
+	}
+	raw := make([]byte, 37)
+	b := value.Bytes()
+	copy(raw[len(raw)-len(b):], b)
+	publicKey, checksum := raw[:32], raw[32:]
+	hash, _ := blake2b.New(5, nil)
+	hash.Write(publicKey)
+	sum := hash.Sum(nil)
+	for i := range sum {
+		if sum[i] != checksum[len(checksum)-1-i] {
+			return "", errChecksum
+		}
+	}
+	return "nano_" + encoded, nil
+}
+
+// nimiqAddress accepts the IBAN style addresses, the canonical form is grouped by 4 characters
+func nimiqAddress(address string) (string, error) {
+	address = strings.ToUpper(strings.Replace(address, " ", "", -1))
+	if len(address) != 36 || !strings.HasPrefix(address, "NQ") {
+		return "", errFormat
+	}
+	for _, c := range address[4:] {
+		if !strings.ContainsRune(nimiqChars, c) {
+			return "", errFormat
+		}
+	}
+	// ISO 13616 check digits: the country code and the check digits are moved to the end
+	// and the letters are replaced by numbers (A = 10)
+	remainder := 0
+	for _, c := range address[4:] + address[:4] {
+		switch {
+		case c >= '0' && c <= '9':
+			remainder = (remainder*10 + int(c-'0')) % 97
+		case c >= 'A' && c <= 'Z':
+			remainder = (remainder*100 + int(c-'A') + 10) % 97
+		default:
+			return "", errFormat
+		}
+	}
+	if remainder != 1 {
+		return "", errChecksum
+	}
+	groups := make([]string, 0, 9)
+	for i := 0; i < len(address); i += 4 {
+		groups = append(groups, address[i:i+4])
+	}
+	return strings.Join(groups, " "), nil
+}
+
+// ss58Address accepts the SS58 public key addresses of the network prefix
+func ss58Address(prefix byte) normalizer {
+	return func(address string) (string, error) {
+		b, err := base58.DecodeAlphabet(address, base58.BTCAlphabet)
+		if err != nil || len(b) != 35 || b[0] != prefix {
+			return "", errFormat
+		}
+		hash := blake2b.Sum512(append([]byte("SS58PRE"), b[:33]...))
+		if !bytes.Equal(hash[:2], b[33:]) {
+			return "", errChecksum
+		}
+		return address, nil
+	}
+}
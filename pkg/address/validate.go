@@ -0,0 +1,102 @@
+package address
+
+import (
+	"github.com/mr-tron/base58"
+	"github.com/trustwallet/blockatlas/coin"
+	"github.com/trustwallet/blockatlas/pkg/errors"
+	"strings"
+)
+
+// normalizer validates an address and returns its canonical form
+type normalizer func(address string) (string, error)
+
+var normalizers = map[uint]normalizer{
+	coin.ETH:   eip55Address,
+	coin.ETC:   eip55Address,
+	coin.POA:   eip55Address,
+	coin.THETA: eip55Address,
+	coin.VET:   eip55Address,
+	coin.CLO:   eip55Address,
+	coin.TOMO:  eip55Address,
+	coin.TT:    eip55Address,
+	coin.GO:    eip55Address,
+	coin.WAN:   wanchainAddress,
+	coin.AION:  aionAddress,
+	coin.ICX:   iconAddress,
+	coin.TRX:   base58CheckAddress(base58.BTCAlphabet, 21),
+	coin.ONT:   base58CheckAddress(base58.BTCAlphabet, 21),
+	coin.XTZ:   base58CheckAddress(base58.BTCAlphabet, 23),
+	coin.XRP:   base58CheckAddress(rippleAlphabet, 21),
+	coin.AE:    aeternityAddress,
+	coin.FIO:   fioAddress,
+	coin.NAS:   nebulasAddress,
+	coin.WAVES: wavesAddress,
+	coin.XLM:   stellarAddress,
+	coin.KIN:   stellarAddress,
+	coin.ALGO:  algorandAddress,
+	coin.NANO:  nanoAddress,
+	coin.NIM:   nimiqAddress,
+	coin.KSM:   ss58Address(2),
+	coin.ATOM:  bech32Address("cosmos"),
+	coin.KAVA:  bech32Address("kava"),
+	coin.BNB:   bech32Address("bnb", "tbnb"),
+	coin.IOTX:  bech32Address("io"),
+	coin.ZIL:   bech32Address("zil"),
+	coin.ONE:   bech32Address("one"),
+	coin.BTC:   utxoAddress(21, "bc"),
+	coin.BTCT:  utxoAddress(21, "tb", "bc"),
+	coin.LTC:   utxoAddress(21, "ltc"),
+	coin.DOGE:  utxoAddress(21),
+	coin.DASH:  utxoAddress(21),
+	coin.VIA:   utxoAddress(21, "via"),
+	coin.ZEC:   utxoAddress(22),
+	coin.ZEL:   utxoAddress(22),
+	coin.XZC:   utxoAddress(21),
+	coin.RVN:   utxoAddress(21),
+	coin.QTUM:  utxoAddress(21, "qc"),
+	coin.DGB:   utxoAddress(21, "dgb"),
+	coin.BCH:   bitcoinCashAddress,
+	// Groestlcoin and Decred checksums use Groestl and BLAKE-256 hashes, only the length is checked
+	coin.GRS: anyAddress(base58Address(25), bech32Address("grs")),
+	coin.DCR: base58Address(26),
+}
+
+// IsSupported tells if the address format of the coin is known
+func IsSupported(coinID uint) bool {
+	_, ok := normalizers[coinID]
+	return ok
+}
+
+// Normalize validates the address of the coin and returns its canonical form,
+// so the same address written differently (e.g. in another case) has a single representation
+func Normalize(coinID uint, address string) (string, error) {
+	normalize, ok := normalizers[coinID]
+	if !ok {
+		return "", errors.E("unsupported coin", errors.Params{"coin": coinID})
+	}
+	normalized, err := normalize(strings.TrimSpace(address))
+	if err != nil {
+		return "", errors.E(err, "invalid address", errors.Params{"coin": coinID, "address": address})
+	}
+	return normalized, nil
+}
+
+// IsValid checks the address format of the coin
+func IsValid(coinID uint, address string) bool {
+	_, err := Normalize(coinID, address)
+	return err == nil
+}
+
+// anyAddress accepts the address when one of the formats accepts it
+func anyAddress(normalizers ...normalizer) normalizer {
+	return func(address string) (string, error) {
+		var err error
+		for _, normalize := range normalizers {
+			var normalized string
+			if normalized, err = normalize(address); err == nil {
+				return normalized, nil
+			}
+		}
+		return "", err
+	}
+}
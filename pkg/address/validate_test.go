@@ -0,0 +1,61 @@
+package address
+
+import (
+	"github.com/trustwallet/blockatlas/coin"
+	"testing"
+)
+
+func TestNormalize_sampleAddresses(t *testing.T) {
+	for id, c := range coin.Coins {
+		if !IsSupported(id) {
+			t.Errorf("%s address format is not supported", c.Symbol)
+			continue
+		}
+		if !IsValid(id, c.SampleAddr) {
+			t.Errorf("%s sample address %s is not valid", c.Symbol, c.SampleAddr)
+		}
+	}
+}
+
+func TestNormalize(t *testing.T) {
+	tests := []struct {
+		name    string
+		coin    uint
+		address string
+		want    string
+		wantErr bool
+	}{
+		{"eip55 lowercase", coin.ETH, "0xfc10cab6a50a1ab10c56983c80cc82afc6559cf1", "0xfc10cAb6a50a1AB10C56983c80cc82afC6559Cf1", false},
+		{"eip55 uppercase", coin.ETH, "0xFC10CAB6A50A1AB10C56983C80CC82AFC6559CF1", "0xfc10cAb6a50a1AB10C56983c80cc82afC6559Cf1", false},
+		{"eip55 broken checksum", coin.ETH, "0xfc10cAb6a50a1AB10C56983c80cc82afC6559CF1", "", true},
+		{"eip55 short", coin.ETH, "0xfc10cab6a50a1ab10c56983c80cc82afc6559c", "", true},
+		{"wanchain lowercase", coin.WAN, "0x36cedc3a9d969306af4f7ca2b83abbf74095914d", "0x36cEdc3A9d969306AF4F7CA2b83ABBf74095914d", false},
+		{"wanchain eip55 checksum", coin.WAN, "0x36CeDC3a9D969306af4f7ca2B83abbF74095914D", "", true},
+		{"bech32 uppercase", coin.ATOM, "COSMOS1RW62PHUSUV9VZRAEZR55K0VSQSSVZ6ED52ZYRL", "cosmos1rw62phusuv9vzraezr55k0vsqssvz6ed52zyrl", false},
+		{"bech32 other prefix", coin.ATOM, "kava13fxkk4730cqglgdv7w0mdelyx07myyq7h2nd3x", "", true},
+		{"bech32 broken checksum", coin.ATOM, "cosmos1rw62phusuv9vzraezr55k0vsqssvz6ed52zyrm", "", true},
+		{"base58check legacy", coin.BTC, "1BvBMSEYstWetqTFn5Au4m4GFg7xJaNVN2", "1BvBMSEYstWetqTFn5Au4m4GFg7xJaNVN2", false},
+		{"base58check broken checksum", coin.BTC, "1BvBMSEYstWetqTFn5Au4m4GFg7xJaNVN3", "", true},
+		{"cashaddr without prefix", coin.BCH, "qq07l6rr5lsdm3m80qxw80ku2ex0tj76vvsxpvmgme", "bitcoincash:qq07l6rr5lsdm3m80qxw80ku2ex0tj76vvsxpvmgme", false},
+		{"cashaddr broken checksum", coin.BCH, "bitcoincash:qq07l6rr5lsdm3m80qxw80ku2ex0tj76vvsxpvmgmf", "", true},
+		{"strkey lowercase", coin.XLM, "gdkijjikxlom2nrmpnqzuuyk24zpvfc6426gzaep3kuk6kejlaccwnmx", "GDKIJJIKXLOM2NRMPNQZUUYK24ZPVFC6426GZAEP3KUK6KEJLACCWNMX", false},
+		{"strkey broken checksum", coin.XLM, "GDKIJJIKXLOM2NRMPNQZUUYK24ZPVFC6426GZAEP3KUK6KEJLACCWNMY", "", true},
+		{"ss58 other network", coin.KSM, "5GrwvaEF5zXb26Fz9rcQpDWS57CtERHpNehXCPcNoHGKutQY", "", true},
+		{"nano legacy prefix", coin.NANO, "xrb_1trqphog5noig7z888asnjejcie8z1iopxyepcjdo1atps8whxiuwd51ehbw", "nano_1trqphog5noig7z888asnjejcie8z1iopxyepcjdo1atps8whxiuwd51ehbw", false},
+		{"nimiq without spaces", coin.NIM, "nq862h8fygu5rm77qsn9lylhc56acyyr0mla", "NQ86 2H8F YGU5 RM77 QSN9 LYLH C56A CYYR 0MLA", false},
+		{"nimiq broken check digits", coin.NIM, "NQ87 2H8F YGU5 RM77 QSN9 LYLH C56A CYYR 0MLA", "", true},
+		{"unsupported coin", 1, "0xfc10cab6a50a1ab10c56983c80cc82afc6559cf1", "", true},
+		{"empty", coin.BTC, "", "", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Normalize(tt.coin, tt.address)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Normalize() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("Normalize() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
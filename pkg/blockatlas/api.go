@@ -1,6 +1,7 @@
 package blockatlas
 
 import (
+	"context"
 	"github.com/gin-gonic/gin"
 	"github.com/trustwallet/blockatlas/coin"
 )
@@ -16,18 +17,111 @@ type TxAPI interface {
 	GetTxsByAddress(address string) (TxPage, error)
 }
 
+// TxAPIWithContext is the TxAPI of the platforms which abort the upstream calls when the context is done,
+// use TxAPIContext to get it from any TxAPI
+type TxAPIWithContext interface {
+	TxAPI
+	GetTxsByAddressWithContext(ctx context.Context, address string) (TxPage, error)
+}
+
 // TokenTxAPI provides token transaction lookups
 type TokenTxAPI interface {
 	Platform
 	GetTokenTxsByAddress(address, token string) (TxPage, error)
 }
 
+// TokenTxAPIWithContext is the TokenTxAPI of the platforms which abort the upstream calls when the context is done
+type TokenTxAPIWithContext interface {
+	TokenTxAPI
+	GetTokenTxsByAddressWithContext(ctx context.Context, address, token string) (TxPage, error)
+}
+
+// TxCursorAPI provides transaction lookups continuing from a cursor.
+// It returns the cursor of the next page, or nil if there are no older transactions.
+type TxCursorAPI interface {
+	Platform
+	GetTxsByAddressFromCursor(ctx context.Context, address string, cursor *TxCursor) (TxCursorPage, error)
+}
+
+// TokenTxCursorAPI provides token transaction lookups continuing from a cursor
+type TokenTxCursorAPI interface {
+	Platform
+	GetTokenTxsByAddressFromCursor(ctx context.Context, address, token string, cursor *TxCursor) (TxCursorPage, error)
+}
+
+// TxByHashAPI provides single transaction lookups, unknown transactions return ErrNotFound
+type TxByHashAPI interface {
+	Platform
+	GetTxByHash(hash string) (*Tx, error)
+}
+
+// TxByHashAPIWithContext is the TxByHashAPI of the platforms which abort the upstream calls when the context is done
+type TxByHashAPIWithContext interface {
+	TxByHashAPI
+	GetTxByHashWithContext(ctx context.Context, hash string) (*Tx, error)
+}
+
 // TokenAPI provides token lookups
 type TokenAPI interface {
 	Platform
 	GetTokenListByAddress(address string) (TokenPage, error)
 }
 
+// TokenAPIWithContext is the TokenAPI of the platforms which abort the upstream calls when the context is done
+type TokenAPIWithContext interface {
+	TokenAPI
+	GetTokenListByAddressWithContext(ctx context.Context, address string) (TokenPage, error)
+}
+
+// BalanceAPI provides the native and the token balances of an address
+type BalanceAPI interface {
+	Platform
+	GetBalance(address string) (*Balance, error)
+}
+
+// BalanceAPIWithContext is the BalanceAPI of the platforms which abort the upstream calls when the context is done
+type BalanceAPIWithContext interface {
+	BalanceAPI
+	GetBalanceWithContext(ctx context.Context, address string) (*Balance, error)
+}
+
+// TokenBalanceAPI provides the balance of a single token,
+// for platforms which can't list all the token balances of an address
+type TokenBalanceAPI interface {
+	Platform
+	GetTokenBalance(address, token string) (*TokenBalance, error)
+}
+
+// TokenBalanceAPIWithContext is the TokenBalanceAPI of the platforms which abort the upstream calls when the context is done
+type TokenBalanceAPIWithContext interface {
+	TokenBalanceAPI
+	GetTokenBalanceWithContext(ctx context.Context, address, token string) (*TokenBalance, error)
+}
+
+// BroadcastAPI broadcasts signed transactions, the failures are returned as *BroadcastError
+type BroadcastAPI interface {
+	Platform
+	BroadcastTransaction(raw string) (txID string, err error)
+}
+
+// BroadcastAPIWithContext is the BroadcastAPI of the platforms which abort the upstream calls when the context is done
+type BroadcastAPIWithContext interface {
+	BroadcastAPI
+	BroadcastTransactionWithContext(ctx context.Context, raw string) (txID string, err error)
+}
+
+// FeeAPI provides fee estimates
+type FeeAPI interface {
+	Platform
+	GetFee(req FeeRequest) (*Fee, error)
+}
+
+// FeeAPIWithContext is the FeeAPI of the platforms which abort the upstream calls when the context is done
+type FeeAPIWithContext interface {
+	FeeAPI
+	GetFeeWithContext(ctx context.Context, req FeeRequest) (*Fee, error)
+}
+
 // BlockAPI provides block information and lookups
 type BlockAPI interface {
 	Platform
@@ -35,6 +129,26 @@ type BlockAPI interface {
 	GetBlockByNumber(num int64) (*Block, error)
 }
 
+// BlockAPIWithContext is the BlockAPI of the platforms which abort the upstream calls when the context is done,
+// use BlockAPIContext to get it from any BlockAPI
+type BlockAPIWithContext interface {
+	BlockAPI
+	CurrentBlockNumberWithContext(ctx context.Context) (int64, error)
+	GetBlockByNumberWithContext(ctx context.Context, num int64) (*Block, error)
+}
+
+// MempoolAPI provides the unconfirmed transactions, the transactions are returned as StatusPending
+type MempoolAPI interface {
+	Platform
+	GetMempoolTxs() (TxPage, error)
+}
+
+// MempoolAPIWithContext is the MempoolAPI of the platforms which abort the upstream calls when the context is done
+type MempoolAPIWithContext interface {
+	MempoolAPI
+	GetMempoolTxsWithContext(ctx context.Context) (TxPage, error)
+}
+
 // AddressAPI provides address information
 type AddressAPI interface {
 	Platform
@@ -50,6 +164,15 @@ type StakeAPI interface {
 	GetDelegations(address string) (DelegationsPage, error)
 }
 
+// StakeAPIWithContext is the StakeAPI of the platforms which abort the upstream calls when the context is done,
+// use StakeAPIContext to get it from any StakeAPI
+type StakeAPIWithContext interface {
+	StakeAPI
+	UndelegatedBalanceWithContext(ctx context.Context, address string) (string, error)
+	GetValidatorsWithContext(ctx context.Context) (ValidatorPage, error)
+	GetDelegationsWithContext(ctx context.Context, address string) (DelegationsPage, error)
+}
+
 type CollectionAPI interface {
 	Platform
 	GetCollections(owner string) (CollectionPage, error)
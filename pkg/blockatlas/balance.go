@@ -0,0 +1,33 @@
+package blockatlas
+
+import "strings"
+
+type (
+	// Balance describes the native and the token balances of an address in the smallest units
+	Balance struct {
+		Coin    uint           `json:"coin"`
+		Address string         `json:"address"`
+		Balance string         `json:"balance"`
+		Tokens  []TokenBalance `json:"tokens,omitempty"`
+	}
+
+	// TokenBalance describes the balance of a single token in the smallest units
+	TokenBalance struct {
+		TokenID  string `json:"token_id"`
+		Symbol   string `json:"symbol,omitempty"`
+		Decimals uint   `json:"decimals,omitempty"`
+		Balance  string `json:"balance"`
+	}
+
+	BalancePage []Balance
+)
+
+// FindToken returns the balance of the token, the token ids are compared case insensitive
+func (b *Balance) FindToken(token string) (TokenBalance, bool) {
+	for _, t := range b.Tokens {
+		if strings.EqualFold(t.TokenID, token) {
+			return t, true
+		}
+	}
+	return TokenBalance{}, false
+}
@@ -0,0 +1,19 @@
+package blockatlas
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestBalance_FindToken(t *testing.T) {
+	balance := Balance{Tokens: []TokenBalance{
+		{TokenID: "0xdac17f958d2ee523a2206206994597c13d831ec7", Balance: "10"},
+	}}
+
+	token, ok := balance.FindToken("0xdAC17F958D2ee523a2206206994597C13D831ec7")
+	assert.True(t, ok)
+	assert.Equal(t, "10", token.Balance)
+
+	_, ok = balance.FindToken("0x0")
+	assert.False(t, ok)
+}
@@ -1,9 +1,13 @@
 package blockatlas
 
 type Block struct {
-	Number int64  `json:"number"`
-	ID     string `json:"id,omitempty"`
-	Txs    []Tx   `json:"txs"`
+	Number   int64  `json:"number"`
+	ID       string `json:"id,omitempty"`
+	ParentID string `json:"parent_id,omitempty"`
+	Txs      []Tx   `json:"txs"`
+	// Transactions of an orphaned block at the same height
+	// which are not part of the canonical chain anymore
+	RevertedTxs []Tx `json:"reverted_txs,omitempty"`
 }
 
 type Subscription struct {
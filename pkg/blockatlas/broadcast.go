@@ -0,0 +1,64 @@
+package blockatlas
+
+import (
+	"errors"
+	"strings"
+)
+
+// Normalized reasons of a rejected transaction broadcast
+var (
+	ErrInvalidTx         = errors.New("invalid transaction")
+	ErrInvalidSignature  = errors.New("invalid signature")
+	ErrNonceTooLow       = errors.New("nonce too low")
+	ErrInsufficientFunds = errors.New("insufficient funds")
+	ErrTxAlreadyKnown    = errors.New("transaction already known")
+)
+
+type (
+	// BroadcastError is a transaction rejected by the node,
+	// Err is one of the normalized reasons and Message is the node response
+	BroadcastError struct {
+		Err     error
+		Message string
+	}
+
+	BroadcastResult struct {
+		ID string `json:"id"`
+	}
+)
+
+func (e *BroadcastError) Error() string {
+	if e.Message == "" {
+		return e.Err.Error()
+	}
+	return e.Err.Error() + ": " + e.Message
+}
+
+// NewBroadcastError guesses the reason of the rejected transaction from the node message,
+// platforms with error codes should map them to the reasons instead
+func NewBroadcastError(message string) *BroadcastError {
+	msg := strings.ToLower(message)
+	var reason error
+	switch {
+	case containsAny(msg, "already", "known transaction", "duplicate"):
+		reason = ErrTxAlreadyKnown
+	case containsAny(msg, "signature", "sig_error", "bad_auth"):
+		reason = ErrInvalidSignature
+	case containsAny(msg, "nonce too low", "sequence", "bad_seq", "past_seq", "counter_in_the_past"):
+		reason = ErrNonceTooLow
+	case containsAny(msg, "insufficient", "balance_too_low", "underfunded", "unfunded", "not sufficient"):
+		reason = ErrInsufficientFunds
+	default:
+		reason = ErrInvalidTx
+	}
+	return &BroadcastError{Err: reason, Message: message}
+}
+
+func containsAny(s string, substrings ...string) bool {
+	for _, sub := range substrings {
+		if strings.Contains(s, sub) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,27 @@
+package blockatlas
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestNewBroadcastError(t *testing.T) {
+	tests := []struct {
+		message string
+		want    error
+	}{
+		{"-26: 16: mandatory-script-verify-flag-failed (Signature must be zero for failed CHECK(MULTI)SIG operation)", ErrInvalidSignature},
+		{"nonce too low", ErrNonceTooLow},
+		{"invalid sequence: expected 12, got 11", ErrNonceTooLow},
+		{"tx_bad_seq", ErrNonceTooLow},
+		{"insufficient funds for gas * price + value", ErrInsufficientFunds},
+		{"proto.005-PsBabyM1.contract.balance_too_low", ErrInsufficientFunds},
+		{"-27: transaction already in block chain", ErrTxAlreadyKnown},
+		{"-22: TX decode failed", ErrInvalidTx},
+	}
+	for _, tt := range tests {
+		err := NewBroadcastError(tt.message)
+		assert.Equal(t, tt.want, err.Err, tt.message)
+		assert.Contains(t, err.Error(), tt.message)
+	}
+}
@@ -2,8 +2,10 @@ package blockatlas
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
+	"github.com/cenkalti/backoff"
 	"github.com/trustwallet/blockatlas/pkg/errors"
 	"io"
 	"io/ioutil"
@@ -18,18 +20,28 @@ type Request struct {
 	Headers      map[string]string
 	HttpClient   *http.Client
 	ErrorHandler func(res *http.Response, uri string) error
+	// Endpoints are the base urls the requests fail over to, BaseUrl is used if nil
+	Endpoints *Endpoints
+	// DecodeErrors unmarshals the JSON error bodies into the result instead of classifying the
+	// response by its status, for the APIs rejecting the requests with an explanation like the broadcasts
+	DecodeErrors bool
 }
 
+// SetTimeout changes the timeout of this client only, the default client is shared by the platforms
 func (r *Request) SetTimeout(seconds time.Duration) {
-	r.HttpClient.Timeout = time.Second * seconds
+	client := *r.HttpClient
+	client.Timeout = time.Second * seconds
+	r.HttpClient = &client
 }
 
+// InitClient creates the client of a base url or a comma separated list of base urls to fail over between
 func InitClient(baseUrl string) Request {
 	return Request{
 		Headers:      make(map[string]string),
 		HttpClient:   DefaultClient,
 		ErrorHandler: DefaultErrorHandler,
-		BaseUrl:      baseUrl,
+		BaseUrl:      PrimaryEndpoint(baseUrl),
+		Endpoints:    GetEndpoints(baseUrl),
 	}
 }
 
@@ -37,52 +49,74 @@ var DefaultClient = &http.Client{
 	Timeout: time.Second * 15,
 }
 
+var (
+	// UpstreamRetries is the number of retries of the idempotent requests which failed on all the endpoints
+	UpstreamRetries uint64 = 2
+	// UpstreamRetryInterval is the initial wait before a retry, it grows exponentially with a random jitter
+	UpstreamRetryInterval = 200 * time.Millisecond
+
+	errEndpointFailure = errors.E("upstream endpoint failure")
+)
+
 var DefaultErrorHandler = func(res *http.Response, uri string) error {
 	return nil
 }
 
 func (r *Request) Get(result interface{}, path string, query url.Values) error {
+	return r.GetWithContext(context.Background(), result, path, query)
+}
+
+// GetWithContext is Get aborting the request and its retries when the context is done
+func (r *Request) GetWithContext(ctx context.Context, result interface{}, path string, query url.Values) error {
 	var queryStr = ""
 	if query != nil {
 		queryStr = query.Encode()
 	}
 	uri := strings.Join([]string{r.GetBase(path), queryStr}, "?")
-	return r.Execute("GET", uri, nil, result)
+	return r.ExecuteWithContext(ctx, "GET", uri, nil, result)
 }
 
 func (r *Request) Post(result interface{}, path string, body interface{}) error {
+	return r.PostWithContext(context.Background(), result, path, body)
+}
+
+// PostWithContext is Post aborting the request when the context is done
+func (r *Request) PostWithContext(ctx context.Context, result interface{}, path string, body interface{}) error {
 	buf, err := GetBody(body)
 	if err != nil {
 		return err
 	}
 	uri := r.GetBase(path)
-	return r.Execute("POST", uri, buf, result)
+	return r.ExecuteWithContext(ctx, "POST", uri, buf, result)
 }
 
 func (r *Request) Execute(method string, url string, body io.Reader, result interface{}) error {
-	req, err := http.NewRequest(method, url, body)
-	if err != nil {
-		return errors.E(err, errors.TypePlatformRequest)
-	}
-
-	for key, value := range r.Headers {
-		req.Header.Set(key, value)
-	}
+	return r.ExecuteWithContext(context.Background(), method, url, body, result)
+}
 
-	res, err := r.HttpClient.Do(req)
+// ExecuteWithContext is Execute aborting the request and its retries when the context is done
+func (r *Request) ExecuteWithContext(ctx context.Context, method string, url string, body io.Reader, result interface{}) error {
+	res, err := r.send(ctx, method, url, body)
 	if err != nil {
-		return errors.E(err, errors.TypePlatformRequest)
+		return err
 	}
 
+	defer res.Body.Close()
 	err = r.ErrorHandler(res, url)
 	if err != nil {
 		return errors.E(err, errors.TypePlatformError)
 	}
-	defer res.Body.Close()
 	b, err := ioutil.ReadAll(res.Body)
 	if err != nil {
 		return errors.E(err, errors.TypePlatformUnmarshal)
 	}
+	// The error pages are often HTML, they are classified by their status instead of being unmarshalled
+	if res.StatusCode >= http.StatusBadRequest {
+		if r.DecodeErrors && json.Unmarshal(b, result) == nil {
+			return nil
+		}
+		return errors.E(NewUpstreamError(res, b), errors.TypePlatformApi, errors.Params{"url": url, "status": res.StatusCode})
+	}
 	err = json.Unmarshal(b, result)
 	if err != nil {
 		return errors.E(err, errors.TypePlatformUnmarshal)
@@ -90,11 +124,166 @@ func (r *Request) Execute(method string, url string, body io.Reader, result inte
 	return err
 }
 
+// send performs the request with the active endpoint, the other endpoints are tried
+// when it can't be reached or responds with a server error. The idempotent requests
+// are retried with a backoff when all the endpoints failed
+func (r *Request) send(ctx context.Context, method string, url string, body io.Reader) (*http.Response, error) {
+	if r.Endpoints == nil {
+		return r.do(ctx, r.HttpClient, method, url, body)
+	}
+	path, ok := r.Endpoints.trimBase(url)
+	if !ok {
+		return r.do(ctx, r.HttpClient, method, url, body)
+	}
+	// The body is read again for every attempt
+	var buf []byte
+	if body != nil {
+		var err error
+		if buf, err = ioutil.ReadAll(body); err != nil {
+			return nil, errors.E(err, errors.TypePlatformRequest)
+		}
+	}
+
+	var res *http.Response
+	attempt := func() error {
+		if res != nil {
+			res.Body.Close()
+		}
+		var err error
+		res, err = r.failover(ctx, method, path, buf)
+		switch {
+		case err == ErrCircuitOpen:
+			return backoff.Permanent(errors.E(err, errors.TypePlatformRequest, errors.Params{"url": url}))
+		case err != nil && ctx.Err() != nil:
+			return backoff.Permanent(errors.E(ctx.Err(), errors.TypePlatformRequest, errors.Params{"url": url}))
+		case err != nil:
+			return err
+		case isEndpointFailure(res.StatusCode):
+			return errEndpointFailure
+		}
+		return nil
+	}
+
+	var err error
+	if isIdempotent(method) && UpstreamRetries > 0 {
+		err = backoff.RetryNotify(attempt, backoff.WithContext(r.retryBackOff(), ctx), func(error, time.Duration) {
+			upstreamRetries.WithLabelValues(r.platform(), hostOf(r.BaseUrl)).Inc()
+		})
+	} else {
+		err = attempt()
+	}
+	// The failure response of the last attempt is handled like any other response
+	if err == errEndpointFailure {
+		return res, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// failover tries the endpoints until one of them responds, it returns the last failure otherwise.
+// The endpoints are only blamed for their outages, not for the requests aborted by the context
+func (r *Request) failover(ctx context.Context, method, path string, body []byte) (*http.Response, error) {
+	client := r.HttpClient
+	if timeout := r.Endpoints.getTimeout(); timeout > 0 {
+		c := *client
+		c.Timeout = timeout
+		client = &c
+	}
+
+	candidates := r.Endpoints.candidates()
+	if len(candidates) == 0 {
+		return nil, ErrCircuitOpen
+	}
+	var (
+		res *http.Response
+		err error
+	)
+	for _, base := range candidates {
+		if res != nil {
+			res.Body.Close()
+		}
+		res, err = r.do(ctx, client, method, base+path, bytes.NewReader(body))
+		if err == nil && !isEndpointFailure(res.StatusCode) {
+			r.Endpoints.succeeded(base)
+			return res, nil
+		}
+		if ctx.Err() != nil {
+			if res != nil {
+				res.Body.Close()
+			}
+			return nil, err
+		}
+		if err != nil || isEndpointOutage(res.StatusCode) {
+			r.Endpoints.failed(base)
+		}
+	}
+	return res, err
+}
+
+func (r *Request) retryBackOff() backoff.BackOff {
+	b := backoff.NewExponentialBackOff()
+	b.InitialInterval = UpstreamRetryInterval
+	b.MaxElapsedTime = 0
+	return backoff.WithMaxRetries(b, UpstreamRetries)
+}
+
+func isIdempotent(method string) bool {
+	return method == http.MethodGet || method == http.MethodHead || method == http.MethodOptions
+}
+
+func (r *Request) do(ctx context.Context, client *http.Client, method string, url string, body io.Reader) (*http.Response, error) {
+	req, err := http.NewRequestWithContext(ctx, method, url, body)
+	if err != nil {
+		return nil, errors.E(err, errors.TypePlatformRequest)
+	}
+
+	for key, value := range r.Headers {
+		req.Header.Set(key, value)
+	}
+
+	start := time.Now()
+	res, err := client.Do(req)
+	if err != nil {
+		observeUpstream(r.platform(), req.URL.Host, method, 0, start)
+		return nil, errors.E(err, errors.TypePlatformRequest)
+	}
+	observeUpstream(r.platform(), req.URL.Host, method, res.StatusCode, start)
+	return res, nil
+}
+
+// platform returns the handle of the platform using the request, it is empty for the urls out of the config
+func (r *Request) platform() string {
+	if r.Endpoints == nil {
+		return ""
+	}
+	return r.Endpoints.getPlatform()
+}
+
+// isEndpointFailure tells if the response is an outage of the endpoint rather than an answer to the request.
+// The rate limits are answers, retrying them sooner than their Retry-After or blaming the endpoint
+// would only extend the limit, they are returned to the caller as an UpstreamRateLimited error
+func isEndpointFailure(status int) bool {
+	return status >= http.StatusInternalServerError
+}
+
+// isEndpointOutage tells if the server error means the endpoint is down, like its unreachable or timed out requests.
+// The other server errors can be caused by the request itself, e.g. an invalid address, they are failed over
+// and retried but don't count towards the circuit breaker of the endpoint
+func isEndpointOutage(status int) bool {
+	return status == http.StatusBadGateway || status == http.StatusServiceUnavailable || status == http.StatusGatewayTimeout
+}
+
 func (r *Request) GetBase(path string) string {
+	base := r.BaseUrl
+	if r.Endpoints != nil {
+		base = r.Endpoints.Active()
+	}
 	if path == "" {
-		return fmt.Sprintf("%s", r.BaseUrl)
+		return fmt.Sprintf("%s", base)
 	}
-	return fmt.Sprintf("%s/%s", r.BaseUrl, path)
+	return fmt.Sprintf("%s/%s", base, path)
 }
 
 func GetBody(body interface{}) (buf io.ReadWriter, err error) {
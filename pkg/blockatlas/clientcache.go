@@ -4,88 +4,44 @@ import (
 	"crypto/sha1"
 	"encoding/base64"
 	"encoding/json"
-	"github.com/patrickmn/go-cache"
+	"github.com/trustwallet/blockatlas/pkg/cache"
 	"github.com/trustwallet/blockatlas/pkg/errors"
-	"github.com/trustwallet/blockatlas/pkg/logger"
-	"github.com/trustwallet/blockatlas/pkg/storage/util"
 	"net/url"
 	"strings"
-	"sync"
 	"time"
 )
 
-var (
-	memoryCache *memCache
-)
-
-func init() {
-	memoryCache = &memCache{cache: cache.New(5*time.Minute, 5*time.Minute)}
-}
+// cacheKeyPrefix keeps the cached upstream responses apart from the other entries of the cache
+const cacheKeyPrefix = "upstream:"
 
-type memCache struct {
-	sync.RWMutex
-	cache *cache.Cache
-}
-
-func (r *Request) PostWithCache(result interface{}, path string, body interface{}, cache time.Duration) error {
+func (r *Request) PostWithCache(result interface{}, path string, body interface{}, duration time.Duration) error {
 	key := r.generateKey(path, nil, body)
-	err := memoryCache.getCache(key, result)
-	if err == nil {
-		return nil
-	}
-
-	err = r.Post(result, path, body)
-	if err != nil {
-		return err
-	}
-	memoryCache.setCache(key, result, cache)
-	return err
+	return r.fetchCache(result, key, duration, func() ([]byte, error) {
+		var raw json.RawMessage
+		err := r.Post(&raw, path, body)
+		return raw, err
+	})
 }
 
-func (r *Request) GetWithCache(result interface{}, path string, query url.Values, cache time.Duration) error {
+func (r *Request) GetWithCache(result interface{}, path string, query url.Values, duration time.Duration) error {
 	key := r.generateKey(path, query, nil)
-	err := memoryCache.getCache(key, result)
-	if err == nil {
-		return nil
-	}
-
-	err = r.Get(result, path, query)
-	if err != nil {
-		return err
-	}
-	memoryCache.setCache(key, result, cache)
-	return err
+	return r.fetchCache(result, key, duration, func() ([]byte, error) {
+		var raw json.RawMessage
+		err := r.Get(&raw, path, query)
+		return raw, err
+	})
 }
 
-func (mc *memCache) deleteCache(key string) {
-	mc.RLock()
-	defer mc.RUnlock()
-	memoryCache.cache.Delete(key)
-}
-
-func (mc *memCache) setCache(key string, value interface{}, duration time.Duration) {
-	mc.RLock()
-	defer mc.RUnlock()
-	b, err := json.Marshal(value)
+// fetchCache reads the response from the cache shared by the replicas, the concurrent misses
+// wait for a single request and the stale responses are served while they are requested again
+func (r *Request) fetchCache(result interface{}, key string, duration time.Duration, load cache.Loader) error {
+	b, err := cache.Fetch(cacheKeyPrefix+key, duration, load, nil)
 	if err != nil {
-		logger.Error(errors.E(err, "client cache cannot marshal cache object"))
-		return
-	}
-	memoryCache.cache.Set(key, b, duration)
-}
-
-func (mc *memCache) getCache(key string, value interface{}) error {
-	c, ok := mc.cache.Get(key)
-	if !ok {
-		return errors.E("validator cache: invalid cache key")
-	}
-	r, ok := c.([]byte)
-	if !ok {
-		return errors.E("validator cache: failed to cast cache to bytes")
+		return err
 	}
-	err := json.Unmarshal(r, value)
+	err = json.Unmarshal(b, result)
 	if err != nil {
-		return errors.E(err, util.ErrNotFound)
+		return errors.E(err, errors.TypePlatformUnmarshal)
 	}
 	return nil
 }
@@ -95,7 +51,12 @@ func (r *Request) generateKey(path string, query url.Values, body interface{}) s
 	if query != nil {
 		queryStr = query.Encode()
 	}
-	requestUrl := strings.Join([]string{r.GetBase(path), queryStr}, "?")
+	// The key is built from the primary endpoint, so it doesn't change when the requests fail over
+	base := r.BaseUrl
+	if path != "" {
+		base = base + "/" + path
+	}
+	requestUrl := strings.Join([]string{base, queryStr}, "?")
 	var b []byte
 	if body != nil {
 		b, _ = json.Marshal(body)
@@ -73,3 +73,15 @@ func TestRequest_generateKey(t *testing.T) {
 		})
 	}
 }
+
+func TestRequest_generateKey_failover(t *testing.T) {
+	r := InitClient("https://primary.io, https://secondary.io")
+	key := r.generateKey("block", nil, nil)
+	r.Endpoints.failed("https://primary.io")
+	if r.GetBase("block") != "https://secondary.io/block" {
+		t.Fatalf("GetBase() = %v, want the secondary endpoint", r.GetBase("block"))
+	}
+	if got := r.generateKey("block", nil, nil); got != key {
+		t.Errorf("generateKey() = %v, want %v", got, key)
+	}
+}
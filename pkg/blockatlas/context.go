@@ -0,0 +1,241 @@
+package blockatlas
+
+import (
+	"context"
+)
+
+// The platforms are migrated to the context aware APIs one by one, until then the shims
+// below adapt the others: the call is skipped when the context is already done,
+// but a call in flight runs to its end
+
+// TxAPIContext returns the platform if it supports contexts, a shim of it otherwise
+func TxAPIContext(api TxAPI) TxAPIWithContext {
+	if a, ok := api.(TxAPIWithContext); ok {
+		return a
+	}
+	return txAPIShim{api}
+}
+
+// TokenTxAPIContext returns the platform if it supports contexts, a shim of it otherwise
+func TokenTxAPIContext(api TokenTxAPI) TokenTxAPIWithContext {
+	if a, ok := api.(TokenTxAPIWithContext); ok {
+		return a
+	}
+	return tokenTxAPIShim{api}
+}
+
+// TxByHashAPIContext returns the platform if it supports contexts, a shim of it otherwise
+func TxByHashAPIContext(api TxByHashAPI) TxByHashAPIWithContext {
+	if a, ok := api.(TxByHashAPIWithContext); ok {
+		return a
+	}
+	return txByHashAPIShim{api}
+}
+
+// TokenAPIContext returns the platform if it supports contexts, a shim of it otherwise
+func TokenAPIContext(api TokenAPI) TokenAPIWithContext {
+	if a, ok := api.(TokenAPIWithContext); ok {
+		return a
+	}
+	return tokenAPIShim{api}
+}
+
+// BalanceAPIContext returns the platform if it supports contexts, a shim of it otherwise
+func BalanceAPIContext(api BalanceAPI) BalanceAPIWithContext {
+	if a, ok := api.(BalanceAPIWithContext); ok {
+		return a
+	}
+	return balanceAPIShim{api}
+}
+
+// TokenBalanceAPIContext returns the platform if it supports contexts, a shim of it otherwise
+func TokenBalanceAPIContext(api TokenBalanceAPI) TokenBalanceAPIWithContext {
+	if a, ok := api.(TokenBalanceAPIWithContext); ok {
+		return a
+	}
+	return tokenBalanceAPIShim{api}
+}
+
+// BroadcastAPIContext returns the platform if it supports contexts, a shim of it otherwise
+func BroadcastAPIContext(api BroadcastAPI) BroadcastAPIWithContext {
+	if a, ok := api.(BroadcastAPIWithContext); ok {
+		return a
+	}
+	return broadcastAPIShim{api}
+}
+
+// FeeAPIContext returns the platform if it supports contexts, a shim of it otherwise
+func FeeAPIContext(api FeeAPI) FeeAPIWithContext {
+	if a, ok := api.(FeeAPIWithContext); ok {
+		return a
+	}
+	return feeAPIShim{api}
+}
+
+// BlockAPIContext returns the platform if it supports contexts, a shim of it otherwise
+func BlockAPIContext(api BlockAPI) BlockAPIWithContext {
+	if a, ok := api.(BlockAPIWithContext); ok {
+		return a
+	}
+	return blockAPIShim{api}
+}
+
+// StakeAPIContext returns the platform if it supports contexts, a shim of it otherwise
+func StakeAPIContext(api StakeAPI) StakeAPIWithContext {
+	if a, ok := api.(StakeAPIWithContext); ok {
+		return a
+	}
+	return stakeAPIShim{api}
+}
+
+// MempoolAPIContext returns the platform if it supports contexts, a shim of it otherwise
+func MempoolAPIContext(api MempoolAPI) MempoolAPIWithContext {
+	if a, ok := api.(MempoolAPIWithContext); ok {
+		return a
+	}
+	return mempoolAPIShim{api}
+}
+
+type (
+	txAPIShim struct {
+		TxAPI
+	}
+
+	tokenTxAPIShim struct {
+		TokenTxAPI
+	}
+
+	txByHashAPIShim struct {
+		TxByHashAPI
+	}
+
+	tokenAPIShim struct {
+		TokenAPI
+	}
+
+	balanceAPIShim struct {
+		BalanceAPI
+	}
+
+	tokenBalanceAPIShim struct {
+		TokenBalanceAPI
+	}
+
+	broadcastAPIShim struct {
+		BroadcastAPI
+	}
+
+	feeAPIShim struct {
+		FeeAPI
+	}
+
+	blockAPIShim struct {
+		BlockAPI
+	}
+
+	stakeAPIShim struct {
+		StakeAPI
+	}
+
+	mempoolAPIShim struct {
+		MempoolAPI
+	}
+)
+
+func (s txAPIShim) GetTxsByAddressWithContext(ctx context.Context, address string) (TxPage, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	return s.GetTxsByAddress(address)
+}
+
+func (s tokenTxAPIShim) GetTokenTxsByAddressWithContext(ctx context.Context, address, token string) (TxPage, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	return s.GetTokenTxsByAddress(address, token)
+}
+
+func (s txByHashAPIShim) GetTxByHashWithContext(ctx context.Context, hash string) (*Tx, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	return s.GetTxByHash(hash)
+}
+
+func (s tokenAPIShim) GetTokenListByAddressWithContext(ctx context.Context, address string) (TokenPage, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	return s.GetTokenListByAddress(address)
+}
+
+func (s balanceAPIShim) GetBalanceWithContext(ctx context.Context, address string) (*Balance, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	return s.GetBalance(address)
+}
+
+func (s tokenBalanceAPIShim) GetTokenBalanceWithContext(ctx context.Context, address, token string) (*TokenBalance, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	return s.GetTokenBalance(address, token)
+}
+
+func (s broadcastAPIShim) BroadcastTransactionWithContext(ctx context.Context, raw string) (string, error) {
+	if err := ctx.Err(); err != nil {
+		return "", err
+	}
+	return s.BroadcastTransaction(raw)
+}
+
+func (s feeAPIShim) GetFeeWithContext(ctx context.Context, req FeeRequest) (*Fee, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	return s.GetFee(req)
+}
+
+func (s blockAPIShim) CurrentBlockNumberWithContext(ctx context.Context) (int64, error) {
+	if err := ctx.Err(); err != nil {
+		return 0, err
+	}
+	return s.CurrentBlockNumber()
+}
+
+func (s blockAPIShim) GetBlockByNumberWithContext(ctx context.Context, num int64) (*Block, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	return s.GetBlockByNumber(num)
+}
+
+func (s stakeAPIShim) UndelegatedBalanceWithContext(ctx context.Context, address string) (string, error) {
+	if err := ctx.Err(); err != nil {
+		return "", err
+	}
+	return s.UndelegatedBalance(address)
+}
+
+func (s stakeAPIShim) GetValidatorsWithContext(ctx context.Context) (ValidatorPage, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	return s.GetValidators()
+}
+
+func (s stakeAPIShim) GetDelegationsWithContext(ctx context.Context, address string) (DelegationsPage, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	return s.GetDelegations(address)
+}
+
+func (s mempoolAPIShim) GetMempoolTxsWithContext(ctx context.Context) (TxPage, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	return s.GetMempoolTxs()
+}
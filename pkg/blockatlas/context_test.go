@@ -0,0 +1,103 @@
+package blockatlas
+
+import (
+	"context"
+	"github.com/stretchr/testify/assert"
+	"github.com/trustwallet/blockatlas/coin"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestRequest_GetWithContext(t *testing.T) {
+	release := make(chan struct{})
+	var calls int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&calls, 1)
+		select {
+		case <-r.Context().Done():
+		case <-release:
+		}
+	}))
+	defer server.Close()
+	defer close(release)
+
+	client := InitClient(server.URL + "," + server.URL + "/backup")
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	var result string
+	start := time.Now()
+	err := client.GetWithContext(ctx, &result, "block", nil)
+	assert.NotNil(t, err)
+	assert.True(t, time.Since(start) < time.Second)
+
+	// The aborted request is neither retried nor blamed on the endpoints
+	assert.Equal(t, int32(1), atomic.LoadInt32(&calls))
+	for _, status := range client.Endpoints.Status() {
+		assert.True(t, status.Healthy)
+	}
+}
+
+type blockAPIStub struct {
+	calls int
+}
+
+func (b *blockAPIStub) Coin() coin.Coin {
+	return coin.Coins[coin.BTC]
+}
+
+func (b *blockAPIStub) CurrentBlockNumber() (int64, error) {
+	b.calls++
+	return 1, nil
+}
+
+func (b *blockAPIStub) GetBlockByNumber(num int64) (*Block, error) {
+	b.calls++
+	return &Block{Number: num}, nil
+}
+
+func TestBlockAPIContext(t *testing.T) {
+	api := &blockAPIStub{}
+	shim := BlockAPIContext(api)
+
+	block, err := shim.GetBlockByNumberWithContext(context.Background(), 2)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(2), block.Number)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, err = shim.CurrentBlockNumberWithContext(ctx)
+	assert.Equal(t, context.Canceled, err)
+	assert.Equal(t, 1, api.calls)
+}
+
+type balanceAPIStub struct {
+	calls int
+}
+
+func (b *balanceAPIStub) Coin() coin.Coin {
+	return coin.Coins[coin.BTC]
+}
+
+func (b *balanceAPIStub) GetBalance(address string) (*Balance, error) {
+	b.calls++
+	return &Balance{Address: address, Balance: "1"}, nil
+}
+
+func TestBalanceAPIContext(t *testing.T) {
+	api := &balanceAPIStub{}
+	shim := BalanceAPIContext(api)
+
+	balance, err := shim.GetBalanceWithContext(context.Background(), "bc1q")
+	assert.Nil(t, err)
+	assert.Equal(t, "bc1q", balance.Address)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, err = shim.GetBalanceWithContext(ctx, "bc1q")
+	assert.Equal(t, context.Canceled, err)
+	assert.Equal(t, 1, api.calls)
+}
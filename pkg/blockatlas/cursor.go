@@ -0,0 +1,233 @@
+package blockatlas
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"github.com/trustwallet/blockatlas/pkg/errors"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// TxCursor points at the last transaction of a page of history.
+// The next page starts right after it.
+type TxCursor struct {
+	Block uint64
+	ID    string
+}
+
+// TxCursorPage is a page of transactions with the cursor of the next page
+type TxCursorPage struct {
+	Txs        TxPage
+	NextCursor *TxCursor
+}
+
+// NewTxCursor returns a cursor positioned at the given transaction
+func NewTxCursor(tx *Tx) *TxCursor {
+	return &TxCursor{Block: tx.Block, ID: tx.ID}
+}
+
+// ParseTxCursor decodes a cursor returned by TxCursor.String
+func ParseTxCursor(s string) (*TxCursor, error) {
+	b, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return nil, errors.E(err, "invalid cursor encoding", errors.Params{"cursor": s})
+	}
+	parts := strings.SplitN(string(b), ":", 2)
+	if len(parts) != 2 || parts[1] == "" {
+		return nil, errors.E("invalid cursor format", errors.Params{"cursor": s})
+	}
+	block, err := strconv.ParseUint(parts[0], 10, 64)
+	if err != nil {
+		return nil, errors.E(err, "invalid cursor block", errors.Params{"cursor": s})
+	}
+	return &TxCursor{Block: block, ID: parts[1]}, nil
+}
+
+// String returns the opaque representation of the cursor
+func (c *TxCursor) String() string {
+	raw := strconv.FormatUint(c.Block, 10) + ":" + c.ID
+	return base64.RawURLEncoding.EncodeToString([]byte(raw))
+}
+
+// Precedes reports whether the transaction comes after the cursor
+// in history order (newest block first, IDs ascending within a block)
+func (c *TxCursor) Precedes(tx *Tx) bool {
+	if tx.Block != c.Block {
+		return tx.Block < c.Block
+	}
+	return tx.ID > c.ID
+}
+
+// SortByHistory sorts the page from the newest to the oldest block.
+// Transactions of the same block are ordered by ID, so positions are stable between requests.
+func (txs TxPage) SortByHistory() {
+	sort.SliceStable(txs, func(i, j int) bool {
+		if txs[i].Block != txs[j].Block {
+			return txs[i].Block > txs[j].Block
+		}
+		return txs[i].ID < txs[j].ID
+	})
+}
+
+// Paginate returns up to limit transactions following the cursor and the cursor of the next page.
+// A nil cursor starts from the newest transaction. The returned cursor is nil on the last page.
+func (txs TxPage) Paginate(cursor *TxCursor, limit int) TxCursorPage {
+	sorted := make(TxPage, len(txs))
+	copy(sorted, txs)
+	sorted.SortByHistory()
+
+	page := make(TxPage, 0, limit)
+	hasMore := false
+	for i := range sorted {
+		if cursor != nil && !cursor.Precedes(&sorted[i]) {
+			continue
+		}
+		if len(page) == limit {
+			hasMore = true
+			break
+		}
+		page = append(page, sorted[i])
+	}
+
+	result := TxCursorPage{Txs: page}
+	if hasMore {
+		result.NextCursor = NewTxCursor(&page[len(page)-1])
+	}
+	return result
+}
+
+// PaginateUpstream paginates the transactions fetched from an upstream API up to the block of the cursor.
+// complete tells if the upstream returned all the transactions up to that block, otherwise the next
+// cursor points at the oldest transaction of the page and the next page is fetched from the upstream again
+func (txs TxPage) PaginateUpstream(cursor *TxCursor, limit int, complete bool) TxCursorPage {
+	page := txs.Paginate(cursor, limit)
+	if page.NextCursor == nil && !complete && len(page.Txs) > 0 {
+		page.NextCursor = NewTxCursor(&page.Txs[len(page.Txs)-1])
+	}
+	return page
+}
+
+// UpstreamPager returns the n-th page (from 1) of the history of an upstream source, newest block first,
+// up to the block to (included, no bound if 0). last tells that the source has no older page
+type UpstreamPager func(to uint64, n int) (txs TxPage, last bool, err error)
+
+// upstreamSource is the state of a pager while a cursor page is fetched
+type upstreamSource struct {
+	fetch UpstreamPager
+	pages int
+	last  bool
+	// oldest is the oldest block returned by the source, it may continue on its next page
+	oldest uint64
+	known  bool
+}
+
+// FetchCursorPage pages the history of the sources from the block of the cursor. The pages of a source
+// may end in the middle of a block, so the oldest block fetched from a source with older pages is not
+// returned until its next pages are fetched, and the sources are paged until enough complete blocks
+// follow the cursor. The cursor block is fetched again from the first page of every source,
+// so the cursor only skips the transactions of a block returned by a previous page.
+// The transactions returned by several sources are only kept once
+func FetchCursorPage(cursor *TxCursor, limit int, pagers ...UpstreamPager) (TxCursorPage, error) {
+	var to uint64
+	if cursor != nil {
+		to = cursor.Block
+	}
+	sources := make([]*upstreamSource, 0, len(pagers))
+	for _, pager := range pagers {
+		sources = append(sources, &upstreamSource{fetch: pager})
+	}
+
+	seen := make(map[string]bool)
+	txs := make(TxPage, 0)
+	for {
+		complete, boundary := upstreamBoundary(sources)
+		if complete || countAfter(txs, cursor, boundary) >= limit {
+			break
+		}
+		// The sources holding the boundary back fetch their next page
+		for _, source := range sources {
+			if source.last || (source.known && source.oldest != boundary) {
+				continue
+			}
+			source.pages++
+			page, last, err := source.fetch(to, source.pages)
+			if err != nil {
+				return TxCursorPage{}, err
+			}
+			source.last = last || len(page) == 0
+			for _, tx := range page {
+				if !source.known || tx.Block < source.oldest {
+					source.oldest, source.known = tx.Block, true
+				}
+				if seen[tx.ID] {
+					continue
+				}
+				seen[tx.ID] = true
+				txs = append(txs, tx)
+			}
+		}
+	}
+
+	complete, boundary := upstreamBoundary(sources)
+	if complete {
+		return txs.PaginateUpstream(cursor, limit, true), nil
+	}
+	fetched := make(TxPage, 0, len(txs))
+	for _, tx := range txs {
+		if tx.Block > boundary {
+			fetched = append(fetched, tx)
+		}
+	}
+	return fetched.PaginateUpstream(cursor, limit, false), nil
+}
+
+// upstreamBoundary returns the newest block which may be incomplete: the newest of the oldest blocks
+// of the sources with older pages. A source which didn't return a block yet holds back every block
+func upstreamBoundary(sources []*upstreamSource) (complete bool, boundary uint64) {
+	complete = true
+	for _, source := range sources {
+		if source.last {
+			continue
+		}
+		complete = false
+		if !source.known {
+			return false, math.MaxUint64
+		}
+		if source.oldest > boundary {
+			boundary = source.oldest
+		}
+	}
+	return
+}
+
+// countAfter returns the number of transactions following the cursor in the blocks newer than the boundary
+func countAfter(txs TxPage, cursor *TxCursor, boundary uint64) (count int) {
+	for i := range txs {
+		if txs[i].Block > boundary && (cursor == nil || cursor.Precedes(&txs[i])) {
+			count++
+		}
+	}
+	return
+}
+
+// MarshalJSON returns a wrapped list of transactions in JSON along with the next cursor
+func (p *TxCursorPage) MarshalJSON() ([]byte, error) {
+	var page struct {
+		Total      int    `json:"total"`
+		Docs       []Tx   `json:"docs"`
+		Status     bool   `json:"status"`
+		NextCursor string `json:"next_cursor,omitempty"`
+	}
+	page.Docs = p.Txs
+	if page.Docs == nil {
+		page.Docs = make([]Tx, 0)
+	}
+	page.Total = len(page.Docs)
+	page.Status = true
+	if p.NextCursor != nil {
+		page.NextCursor = p.NextCursor.String()
+	}
+	return json.Marshal(page)
+}
@@ -0,0 +1,146 @@
+package blockatlas
+
+import (
+	"encoding/json"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestParseTxCursor(t *testing.T) {
+	cursor := TxCursor{Block: 7761368, ID: "1681EE543FB4B5A628EF21D746E031F018E226D127044A4F9BA5EE2542A44555"}
+	parsed, err := ParseTxCursor(cursor.String())
+	assert.Nil(t, err)
+	assert.Equal(t, cursor, *parsed)
+
+	for _, invalid := range []string{"???", "MTIz", "YWJjOmRlZg"} {
+		_, err := ParseTxCursor(invalid)
+		assert.NotNil(t, err, invalid)
+	}
+}
+
+func TestTxPage_Paginate(t *testing.T) {
+	txs := TxPage{
+		{ID: "b", Block: 10},
+		{ID: "c", Block: 12},
+		{ID: "a", Block: 10},
+		{ID: "d", Block: 9},
+		{ID: "e", Block: 11},
+	}
+
+	first := txs.Paginate(nil, 2)
+	assert.Equal(t, []string{"c", "e"}, txIDs(first.Txs))
+	assert.Equal(t, &TxCursor{Block: 11, ID: "e"}, first.NextCursor)
+
+	second := txs.Paginate(first.NextCursor, 2)
+	assert.Equal(t, []string{"a", "b"}, txIDs(second.Txs))
+	assert.Equal(t, &TxCursor{Block: 10, ID: "b"}, second.NextCursor)
+
+	last := txs.Paginate(second.NextCursor, 2)
+	assert.Equal(t, []string{"d"}, txIDs(last.Txs))
+	assert.Nil(t, last.NextCursor)
+
+	exact := txs.Paginate(nil, len(txs))
+	assert.Len(t, exact.Txs, len(txs))
+	assert.Nil(t, exact.NextCursor)
+
+	// The source page must keep its order
+	assert.Equal(t, []string{"b", "c", "a", "d", "e"}, txIDs(txs))
+}
+
+func TestTxPage_PaginateUpstream(t *testing.T) {
+	txs := TxPage{{ID: "b", Block: 10}, {ID: "a", Block: 10}, {ID: "c", Block: 12}}
+
+	complete := txs.PaginateUpstream(nil, 5, true)
+	assert.Equal(t, []string{"c", "a", "b"}, txIDs(complete.Txs))
+	assert.Nil(t, complete.NextCursor)
+
+	// The upstream has older transactions than the fetched ones
+	partial := txs.PaginateUpstream(nil, 5, false)
+	assert.Equal(t, &TxCursor{Block: 10, ID: "b"}, partial.NextCursor)
+
+	// The transactions up to the cursor were returned by the previous page
+	next := txs.PaginateUpstream(partial.NextCursor, 5, false)
+	assert.Empty(t, next.Txs)
+	assert.Nil(t, next.NextCursor)
+}
+
+// upstreamHistory pages the transactions newest first like the explorers do, without caring for the blocks
+func upstreamHistory(history TxPage, size int) UpstreamPager {
+	return func(to uint64, n int) (TxPage, bool, error) {
+		bounded := make(TxPage, 0)
+		for _, tx := range history {
+			if to == 0 || tx.Block <= to {
+				bounded = append(bounded, tx)
+			}
+		}
+		start := (n - 1) * size
+		if start >= len(bounded) {
+			return TxPage{}, true, nil
+		}
+		end := start + size
+		if end > len(bounded) {
+			end = len(bounded)
+		}
+		return bounded[start:end], end == len(bounded), nil
+	}
+}
+
+func TestFetchCursorPage(t *testing.T) {
+	// Block 10 spans the upstream pages and holds more transactions than a page
+	history := TxPage{
+		{ID: "f", Block: 12},
+		{ID: "e", Block: 11},
+		{ID: "d", Block: 10}, {ID: "a", Block: 10}, {ID: "c", Block: 10}, {ID: "b", Block: 10},
+		{ID: "g", Block: 9},
+		{ID: "h", Block: 8},
+	}
+	pager := upstreamHistory(history, 3)
+
+	var ids []string
+	var cursor *TxCursor
+	for i := 0; i < len(history); i++ {
+		page, err := FetchCursorPage(cursor, 2, pager)
+		assert.Nil(t, err)
+		ids = append(ids, txIDs(page.Txs)...)
+		if page.NextCursor == nil {
+			break
+		}
+		cursor = page.NextCursor
+	}
+	assert.Equal(t, []string{"f", "e", "a", "b", "c", "d", "g", "h"}, ids)
+
+	// The transactions returned by several sources are kept once
+	page, err := FetchCursorPage(nil, 10, pager, upstreamHistory(history[:3], 2))
+	assert.Nil(t, err)
+	assert.Len(t, page.Txs, len(history))
+	assert.Nil(t, page.NextCursor)
+}
+
+func TestTxCursorPage_MarshalJSON(t *testing.T) {
+	page := TxCursorPage{
+		Txs:        TxPage{transferDst1},
+		NextCursor: NewTxCursor(&transferDst1),
+	}
+	b, err := json.Marshal(&page)
+	assert.Nil(t, err)
+
+	var result struct {
+		Total      int    `json:"total"`
+		NextCursor string `json:"next_cursor"`
+	}
+	assert.Nil(t, json.Unmarshal(b, &result))
+	assert.Equal(t, 1, result.Total)
+	assert.Equal(t, page.NextCursor.String(), result.NextCursor)
+
+	b, err = json.Marshal(&TxCursorPage{})
+	assert.Nil(t, err)
+	assert.JSONEq(t, `{"total":0,"docs":[],"status":true}`, string(b))
+}
+
+func txIDs(txs TxPage) []string {
+	ids := make([]string, 0, len(txs))
+	for _, tx := range txs {
+		ids = append(ids, tx.ID)
+	}
+	return ids
+}
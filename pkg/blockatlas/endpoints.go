@@ -0,0 +1,337 @@
+package blockatlas
+
+import (
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+)
+
+var (
+	// EndpointProbeInterval is the time after which a failed endpoint is probed again,
+	// a background prober requests its base url and puts it back in rotation once it responds
+	EndpointProbeInterval = 30 * time.Second
+
+	// BreakerThreshold is the number of consecutive failures which opens the circuit of an endpoint:
+	// the requests fail fast until a probe succeeds after EndpointProbeInterval
+	BreakerThreshold = 5
+
+	// minProbeTick keeps the prober from spinning when EndpointProbeInterval is tiny
+	minProbeTick = time.Second
+)
+
+const (
+	BreakerClosed   = "closed"
+	BreakerOpen     = "open"
+	BreakerHalfOpen = "half_open"
+)
+
+var (
+	endpointsMu sync.Mutex
+	// endpointPools shares the health of the endpoints between the clients of the same config value
+	endpointPools = make(map[string]*Endpoints)
+)
+
+type (
+	// Endpoints is an ordered list of interchangeable base urls, the requests use the first
+	// healthy one and fail over to the next one on connection errors, timeouts and 5xx responses
+	Endpoints struct {
+		mu     sync.RWMutex
+		urls   []string
+		states []endpointState
+		// timeout overrides the timeout of the http client, 0 keeps it
+		timeout time.Duration
+		// platform is the handle of the platform using the endpoints, it labels the upstream metrics
+		platform string
+	}
+
+	endpointState struct {
+		failures int
+		failedAt time.Time
+	}
+
+	EndpointStatus struct {
+		URL      string     `json:"url"`
+		Active   bool       `json:"active"`
+		Healthy  bool       `json:"healthy"`
+		Breaker  string     `json:"breaker"`
+		Failures int        `json:"failures,omitempty"`
+		FailedAt *time.Time `json:"failed_at,omitempty"`
+	}
+)
+
+// ParseEndpoints splits a comma separated list of base urls
+func ParseEndpoints(value string) []string {
+	urls := make([]string, 0)
+	for _, u := range strings.Split(value, ",") {
+		u = strings.TrimSpace(u)
+		if len(u) > 0 {
+			urls = append(urls, u)
+		}
+	}
+	return urls
+}
+
+// PrimaryEndpoint returns the first base url of a comma separated list
+func PrimaryEndpoint(value string) string {
+	urls := ParseEndpoints(value)
+	if len(urls) == 0 {
+		return ""
+	}
+	return urls[0]
+}
+
+// GetEndpoints returns the endpoints of a comma separated list of base urls,
+// the same list always returns the same endpoints and starts their prober once
+func GetEndpoints(value string) *Endpoints {
+	urls := ParseEndpoints(value)
+	if len(urls) == 0 {
+		return nil
+	}
+	endpointsMu.Lock()
+	defer endpointsMu.Unlock()
+	if e, ok := endpointPools[value]; ok {
+		return e
+	}
+	e := NewEndpoints(urls)
+	endpointPools[value] = e
+	go e.runProber()
+	return e
+}
+
+// FindEndpoints returns the endpoints of the list if a client uses them, nil otherwise
+func FindEndpoints(value string) *Endpoints {
+	endpointsMu.Lock()
+	defer endpointsMu.Unlock()
+	return endpointPools[value]
+}
+
+func NewEndpoints(urls []string) *Endpoints {
+	return &Endpoints{
+		urls:   urls,
+		states: make([]endpointState, len(urls)),
+	}
+}
+
+// SetTimeout sets the timeout of the requests to the endpoints
+func (e *Endpoints) SetTimeout(timeout time.Duration) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.timeout = timeout
+}
+
+func (e *Endpoints) getTimeout() time.Duration {
+	e.mu.RLock()
+	defer e.mu.RUnlock()
+	return e.timeout
+}
+
+// SetPlatform sets the handle of the platform the upstream metrics of the endpoints are labeled with
+func (e *Endpoints) SetPlatform(handle string) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.platform = handle
+}
+
+func (e *Endpoints) getPlatform() string {
+	e.mu.RLock()
+	defer e.mu.RUnlock()
+	return e.platform
+}
+
+// Active returns the base url the next request is sent to
+func (e *Endpoints) Active() string {
+	e.mu.RLock()
+	defer e.mu.RUnlock()
+	return e.urls[e.active(time.Now())]
+}
+
+func (e *Endpoints) active(now time.Time) int {
+	for i := range e.urls {
+		if e.healthy(i) {
+			return i
+		}
+	}
+	for i := range e.urls {
+		if e.breaker(i, now) == BreakerClosed {
+			return i
+		}
+	}
+	// All the endpoints are down, the one which failed first is the most likely to be back
+	oldest := 0
+	for i, state := range e.states {
+		if state.failedAt.Before(e.states[oldest].failedAt) {
+			oldest = i
+		}
+	}
+	return oldest
+}
+
+// healthy tells if the endpoint responded to its last request, a failed endpoint stays out of
+// rotation until the prober or a request sent to it as the last resort succeeds
+func (e *Endpoints) healthy(i int) bool {
+	return e.states[i].failures == 0
+}
+
+func (e *Endpoints) breaker(i int, now time.Time) string {
+	state := e.states[i]
+	switch {
+	case state.failures < BreakerThreshold:
+		return BreakerClosed
+	case now.Sub(state.failedAt) < EndpointProbeInterval:
+		return BreakerOpen
+	default:
+		return BreakerHalfOpen
+	}
+}
+
+// candidates returns the base urls in the order they are tried: the healthy ones by priority
+// and then the failed ones, without the ones with an open circuit
+func (e *Endpoints) candidates() []string {
+	e.mu.RLock()
+	defer e.mu.RUnlock()
+	result := make([]string, 0, len(e.urls))
+	for i, u := range e.urls {
+		if e.healthy(i) {
+			result = append(result, u)
+		}
+	}
+	for i, u := range e.urls {
+		if !e.healthy(i) && e.states[i].failures < BreakerThreshold {
+			result = append(result, u)
+		}
+	}
+	return result
+}
+
+// runProber probes the failed endpoints in the background for the lifetime of the process
+func (e *Endpoints) runProber() {
+	for {
+		tick := EndpointProbeInterval
+		if tick < minProbeTick {
+			tick = minProbeTick
+		}
+		time.Sleep(tick)
+		e.probe()
+	}
+}
+
+// probe requests the base urls of the endpoints which failed more than EndpointProbeInterval ago,
+// any response but an outage puts the endpoint back in rotation and closes its circuit
+func (e *Endpoints) probe() {
+	client := *DefaultClient
+	if timeout := e.getTimeout(); timeout > 0 {
+		client.Timeout = timeout
+	}
+	for _, base := range e.due(time.Now()) {
+		res, err := client.Get(base)
+		if err != nil {
+			e.failed(base)
+			continue
+		}
+		res.Body.Close()
+		if isEndpointOutage(res.StatusCode) {
+			e.failed(base)
+			continue
+		}
+		e.succeeded(base)
+	}
+}
+
+// due returns the base urls of the failed endpoints to probe
+func (e *Endpoints) due(now time.Time) []string {
+	e.mu.RLock()
+	defer e.mu.RUnlock()
+	result := make([]string, 0)
+	for i, u := range e.urls {
+		if !e.healthy(i) && now.Sub(e.states[i].failedAt) >= EndpointProbeInterval {
+			result = append(result, u)
+		}
+	}
+	return result
+}
+
+// trimBase returns the path of the url after the longest base url of the endpoints it starts with
+func (e *Endpoints) trimBase(rawurl string) (string, bool) {
+	base := ""
+	for _, u := range e.urls {
+		if strings.HasPrefix(rawurl, u) && len(u) > len(base) {
+			base = u
+		}
+	}
+	if len(base) == 0 {
+		return "", false
+	}
+	return strings.TrimPrefix(rawurl, base), true
+}
+
+func (e *Endpoints) succeeded(base string) {
+	e.update(base, func(state *endpointState) {
+		if state.failures >= BreakerThreshold {
+			upstreamCircuitOpen.WithLabelValues(hostOf(base)).Set(0)
+		}
+		*state = endpointState{}
+	})
+}
+
+func (e *Endpoints) failed(base string) {
+	e.update(base, func(state *endpointState) {
+		state.failures++
+		state.failedAt = time.Now()
+		if state.failures == BreakerThreshold {
+			upstreamCircuitOpen.WithLabelValues(hostOf(base)).Set(1)
+		}
+	})
+}
+
+func (e *Endpoints) update(base string, f func(state *endpointState)) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	for i, u := range e.urls {
+		if u == base {
+			f(&e.states[i])
+			return
+		}
+	}
+}
+
+// Status returns the state of every endpoint, the urls are reduced to the scheme and the host
+// because the paths and the queries of the explorers often contain API keys
+func (e *Endpoints) Status() []EndpointStatus {
+	e.mu.RLock()
+	defer e.mu.RUnlock()
+	now := time.Now()
+	active := e.active(now)
+	result := make([]EndpointStatus, 0, len(e.urls))
+	for i, u := range e.urls {
+		status := EndpointStatus{
+			URL:      redactURL(u),
+			Active:   i == active,
+			Healthy:  e.states[i].failures == 0,
+			Breaker:  e.breaker(i, now),
+			Failures: e.states[i].failures,
+		}
+		if !status.Healthy {
+			failedAt := e.states[i].failedAt
+			status.FailedAt = &failedAt
+		}
+		result = append(result, status)
+	}
+	return result
+}
+
+func hostOf(rawurl string) string {
+	u, err := url.Parse(rawurl)
+	if err != nil {
+		return ""
+	}
+	return u.Host
+}
+
+func redactURL(rawurl string) string {
+	u, err := url.Parse(rawurl)
+	if err != nil || len(u.Host) == 0 {
+		return "invalid url"
+	}
+	return u.Scheme + "://" + u.Host
+}
@@ -0,0 +1,247 @@
+package blockatlas
+
+import (
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestRequest_failover(t *testing.T) {
+	var primaryDown bool
+	primary := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if primaryDown {
+			w.WriteHeader(http.StatusBadGateway)
+			return
+		}
+		_, _ = w.Write([]byte(`"primary` + r.URL.Path + `"`))
+	}))
+	defer primary.Close()
+	secondary := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`"secondary` + r.URL.Path + `"`))
+	}))
+	defer secondary.Close()
+
+	defer func(interval time.Duration) { EndpointProbeInterval = interval }(EndpointProbeInterval)
+	EndpointProbeInterval = time.Hour
+
+	client := InitClient(primary.URL + ", " + secondary.URL)
+	assert.Equal(t, primary.URL, client.BaseUrl)
+
+	var result string
+	assert.Nil(t, client.Get(&result, "block", nil))
+	assert.Equal(t, "primary/block", result)
+
+	primaryDown = true
+	assert.Nil(t, client.Post(&result, "block", map[string]int{"number": 1}))
+	assert.Equal(t, "secondary/block", result)
+	assert.Equal(t, secondary.URL, client.Endpoints.Active())
+
+	status := client.Endpoints.Status()
+	assert.False(t, status[0].Healthy)
+	assert.False(t, status[0].Active)
+	assert.True(t, status[1].Active)
+
+	// The requests don't go to the failed endpoint until it is probed again after the interval
+	primaryDown = false
+	assert.Nil(t, client.Get(&result, "block", nil))
+	assert.Equal(t, "secondary/block", result)
+	client.Endpoints.probe()
+	assert.Equal(t, secondary.URL, client.Endpoints.Active())
+	EndpointProbeInterval = 0
+	client.Endpoints.probe()
+	assert.Nil(t, client.Get(&result, "block", nil))
+	assert.Equal(t, "primary/block", result)
+	assert.Equal(t, primary.URL, client.Endpoints.Active())
+}
+
+func TestRequest_allEndpointsDown(t *testing.T) {
+	down := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer down.Close()
+
+	client := InitClient(down.URL + ",http://127.0.0.1:1")
+	var result string
+	assert.NotNil(t, client.Get(&result, "block", nil))
+	for _, status := range client.Endpoints.Status() {
+		assert.False(t, status.Healthy)
+	}
+}
+
+func TestEndpoints_probe(t *testing.T) {
+	var down = true
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if down {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		// Any answer but a server error means the endpoint is up
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer server.Close()
+
+	defer func(interval time.Duration) { EndpointProbeInterval = interval }(EndpointProbeInterval)
+	EndpointProbeInterval = 0
+
+	e := NewEndpoints([]string{server.URL})
+	e.failed(server.URL)
+	e.probe()
+	assert.Equal(t, 2, e.Status()[0].Failures)
+
+	down = false
+	e.probe()
+	assert.True(t, e.Status()[0].Healthy)
+}
+
+func TestParseEndpoints(t *testing.T) {
+	assert.Equal(t, []string{"https://a.io", "https://b.io/api"}, ParseEndpoints(" https://a.io,,https://b.io/api "))
+	assert.Equal(t, []string{}, ParseEndpoints(""))
+	assert.Equal(t, "", PrimaryEndpoint(""))
+	assert.Equal(t, "https://a.io", redactURL("https://a.io/v3/secret-key?apikey=secret"))
+}
+
+func TestRequest_retry(t *testing.T) {
+	var calls int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		if calls < 3 {
+			w.WriteHeader(http.StatusBadGateway)
+			return
+		}
+		_, _ = w.Write([]byte(`"ok"`))
+	}))
+	defer server.Close()
+
+	defer func(interval time.Duration) { UpstreamRetryInterval = interval }(UpstreamRetryInterval)
+	UpstreamRetryInterval = time.Millisecond
+
+	client := InitClient(server.URL)
+	var result string
+	assert.Nil(t, client.Get(&result, "", nil))
+	assert.Equal(t, "ok", result)
+	assert.Equal(t, 3, calls)
+
+	// The retries are counted by platform
+	client.Endpoints.SetPlatform("retrycoin")
+	calls = 0
+	assert.Nil(t, client.Get(&result, "", nil))
+	assert.Equal(t, float64(2), testutil.ToFloat64(upstreamRetries.WithLabelValues("retrycoin", hostOf(server.URL))))
+
+	// The requests which are not idempotent are not retried
+	calls = 0
+	assert.NotNil(t, client.Post(&result, "", nil))
+	assert.Equal(t, 1, calls)
+}
+
+func TestRequest_circuitBreaker(t *testing.T) {
+	var calls int
+	var down = true
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		if down {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		_, _ = w.Write([]byte(`"ok"`))
+	}))
+	defer server.Close()
+
+	defer func(retries uint64, threshold int, interval time.Duration) {
+		UpstreamRetries, BreakerThreshold, EndpointProbeInterval = retries, threshold, interval
+	}(UpstreamRetries, BreakerThreshold, EndpointProbeInterval)
+	UpstreamRetries = 0
+	BreakerThreshold = 2
+	EndpointProbeInterval = time.Hour
+
+	client := InitClient(server.URL + "/breaker")
+	var result string
+	for i := 0; i < BreakerThreshold; i++ {
+		assert.NotNil(t, client.Get(&result, "", nil))
+	}
+	assert.Equal(t, BreakerOpen, client.Endpoints.Status()[0].Breaker)
+
+	// The open circuit fails fast without calling the upstream
+	err := client.Get(&result, "", nil)
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), ErrCircuitOpen.Error())
+	assert.Equal(t, BreakerThreshold, calls)
+
+	// A probe closes the circuit after the interval
+	down = false
+	EndpointProbeInterval = 0
+	assert.Equal(t, BreakerHalfOpen, client.Endpoints.Status()[0].Breaker)
+	client.Endpoints.probe()
+	assert.Equal(t, BreakerClosed, client.Endpoints.Status()[0].Breaker)
+	assert.Nil(t, client.Get(&result, "", nil))
+}
+
+func TestRequest_circuitBreaker_serverError(t *testing.T) {
+	var calls int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer server.Close()
+
+	defer func(retries uint64, threshold int) {
+		UpstreamRetries, BreakerThreshold = retries, threshold
+	}(UpstreamRetries, BreakerThreshold)
+	UpstreamRetries = 0
+	BreakerThreshold = 2
+
+	// The server errors caused by the request don't open the circuit
+	client := InitClient(server.URL + "/invalid")
+	var result string
+	for i := 0; i < 2*BreakerThreshold; i++ {
+		assert.NotNil(t, client.Get(&result, "", nil))
+	}
+	assert.Equal(t, 2*BreakerThreshold, calls)
+	status := client.Endpoints.Status()[0]
+	assert.Equal(t, BreakerClosed, status.Breaker)
+	assert.True(t, status.Healthy)
+}
+
+func TestRequest_rateLimited(t *testing.T) {
+	var calls int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		w.Header().Set("Retry-After", "30")
+		w.WriteHeader(http.StatusTooManyRequests)
+	}))
+	defer server.Close()
+	secondary := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`"secondary"`))
+	}))
+	defer secondary.Close()
+
+	defer func(threshold int, interval time.Duration) {
+		BreakerThreshold, UpstreamRetryInterval = threshold, interval
+	}(BreakerThreshold, UpstreamRetryInterval)
+	BreakerThreshold = 1
+	UpstreamRetryInterval = time.Millisecond
+
+	// The rate limit is neither retried, failed over nor counted by the breaker
+	client := InitClient(server.URL + "/limited," + secondary.URL)
+	var result string
+	err := client.Get(&result, "", nil)
+	upstreamErr := AsUpstreamError(err)
+	if assert.NotNil(t, upstreamErr) {
+		assert.Equal(t, UpstreamRateLimited, upstreamErr.Kind)
+		assert.Equal(t, 30*time.Second, upstreamErr.RetryAfter)
+	}
+	assert.Equal(t, 1, calls)
+	status := client.Endpoints.Status()
+	assert.True(t, status[0].Healthy)
+	assert.True(t, status[0].Active)
+	assert.Equal(t, BreakerClosed, status[0].Breaker)
+}
+
+func TestRequest_SetTimeout(t *testing.T) {
+	client := InitClient("https://a.io")
+	client.SetTimeout(35)
+	assert.Equal(t, 35*time.Second, client.HttpClient.Timeout)
+	assert.NotEqual(t, client.HttpClient.Timeout, DefaultClient.Timeout)
+}
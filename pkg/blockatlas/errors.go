@@ -1,6 +1,12 @@
 package blockatlas
 
-import "errors"
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+)
 
 // ErrSourceConn signals that the connection to the source API failed
 var ErrSourceConn = errors.New("connection to servers failed")
@@ -10,3 +16,110 @@ var ErrInvalidAddr = errors.New("invalid address")
 
 // ErrNotFound signals that the resource has not been found
 var ErrNotFound = errors.New("not found")
+
+// ErrCircuitOpen signals that the circuit breakers of all the upstream endpoints are open
+// and the request was not sent
+var ErrCircuitOpen = errors.New("upstream circuit breaker is open")
+
+const (
+	UpstreamNotFound    UpstreamErrorKind = "not_found"
+	UpstreamRateLimited UpstreamErrorKind = "rate_limited"
+	UpstreamUnavailable UpstreamErrorKind = "unavailable"
+	UpstreamBadRequest  UpstreamErrorKind = "bad_request"
+
+	// upstreamMessageSize is the length of the response body kept in the error
+	upstreamMessageSize = 256
+)
+
+type (
+	UpstreamErrorKind string
+
+	// UpstreamError is an unsuccessful response of an upstream API classified by its status,
+	// the not found and unavailable kinds match ErrNotFound and ErrSourceConn with errors.Is
+	UpstreamError struct {
+		Kind       UpstreamErrorKind
+		StatusCode int
+		// RetryAfter is the delay asked by a rate limited response, 0 if it is not given
+		RetryAfter time.Duration
+		// Message is the beginning of the response body
+		Message string
+	}
+)
+
+func (e *UpstreamError) Error() string {
+	if len(e.Message) == 0 {
+		return fmt.Sprintf("upstream %s: status %d", e.Kind, e.StatusCode)
+	}
+	return fmt.Sprintf("upstream %s: status %d: %s", e.Kind, e.StatusCode, e.Message)
+}
+
+func (e *UpstreamError) Is(target error) bool {
+	switch target {
+	case ErrNotFound:
+		return e.Kind == UpstreamNotFound
+	case ErrSourceConn:
+		return e.Kind == UpstreamUnavailable
+	default:
+		return false
+	}
+}
+
+// AsUpstreamError returns the upstream error wrapped by the error, nil if there is none
+func AsUpstreamError(err error) *UpstreamError {
+	var upstreamErr *UpstreamError
+	if errors.As(err, &upstreamErr) {
+		return upstreamErr
+	}
+	return nil
+}
+
+// NewUpstreamError classifies the unsuccessful response, the body is not closed
+func NewUpstreamError(res *http.Response, body []byte) *UpstreamError {
+	e := &UpstreamError{
+		Kind:       classifyStatus(res.StatusCode),
+		StatusCode: res.StatusCode,
+		Message:    string(body),
+	}
+	if len(e.Message) > upstreamMessageSize {
+		e.Message = e.Message[:upstreamMessageSize]
+	}
+	if e.Kind == UpstreamRateLimited {
+		e.RetryAfter = parseRetryAfter(res.Header.Get("Retry-After"), time.Now())
+	}
+	return e
+}
+
+// classifyStatus tells the kind of an unsuccessful status. The authorization failures are outages:
+// the client can't fix them, only the API keys of the explorer
+func classifyStatus(status int) UpstreamErrorKind {
+	switch {
+	case status == http.StatusNotFound || status == http.StatusGone:
+		return UpstreamNotFound
+	case status == http.StatusTooManyRequests:
+		return UpstreamRateLimited
+	case status >= http.StatusInternalServerError,
+		status == http.StatusUnauthorized,
+		status == http.StatusForbidden,
+		status == http.StatusRequestTimeout:
+		return UpstreamUnavailable
+	default:
+		return UpstreamBadRequest
+	}
+}
+
+// parseRetryAfter reads the delay in seconds or the date of the Retry-After header
+func parseRetryAfter(value string, now time.Time) time.Duration {
+	if len(value) == 0 {
+		return 0
+	}
+	if seconds, err := strconv.Atoi(value); err == nil {
+		if seconds < 0 {
+			return 0
+		}
+		return time.Duration(seconds) * time.Second
+	}
+	if date, err := http.ParseTime(value); err == nil && date.After(now) {
+		return date.Sub(now)
+	}
+	return 0
+}
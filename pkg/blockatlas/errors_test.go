@@ -0,0 +1,100 @@
+package blockatlas
+
+import (
+	"errors"
+	"github.com/stretchr/testify/assert"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestRequest_Execute_upstreamErrors(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/missing":
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte("<html>Not Found</html>"))
+		case "/limited":
+			w.Header().Set("Retry-After", "7")
+			w.WriteHeader(http.StatusTooManyRequests)
+		case "/down":
+			w.WriteHeader(http.StatusBadGateway)
+		case "/invalid":
+			w.WriteHeader(http.StatusBadRequest)
+			_, _ = w.Write([]byte(`{"error":"invalid address"}`))
+		}
+	}))
+	defer server.Close()
+
+	defer func(retries uint64) { UpstreamRetries = retries }(UpstreamRetries)
+	UpstreamRetries = 0
+
+	tests := []struct {
+		path       string
+		kind       UpstreamErrorKind
+		retryAfter time.Duration
+		message    string
+	}{
+		{"missing", UpstreamNotFound, 0, "<html>Not Found</html>"},
+		{"limited", UpstreamRateLimited, 7 * time.Second, ""},
+		{"down", UpstreamUnavailable, 0, ""},
+		{"invalid", UpstreamBadRequest, 0, `{"error":"invalid address"}`},
+	}
+	client := InitClient(server.URL)
+	for _, tt := range tests {
+		t.Run(tt.path, func(t *testing.T) {
+			var result map[string]string
+			err := client.Get(&result, tt.path, nil)
+			upstreamErr := AsUpstreamError(err)
+			if assert.NotNil(t, upstreamErr) {
+				assert.Equal(t, tt.kind, upstreamErr.Kind)
+				assert.Equal(t, tt.retryAfter, upstreamErr.RetryAfter)
+				assert.Equal(t, tt.message, upstreamErr.Message)
+			}
+		})
+	}
+
+	var result map[string]string
+	assert.True(t, errors.Is(client.Get(&result, "missing", nil), ErrNotFound))
+	assert.True(t, errors.Is(client.Get(&result, "down", nil), ErrSourceConn))
+}
+
+func TestParseRetryAfter(t *testing.T) {
+	now := time.Date(2020, 3, 1, 12, 0, 0, 0, time.UTC)
+	assert.Equal(t, 120*time.Second, parseRetryAfter("120", now))
+	assert.Equal(t, 30*time.Second, parseRetryAfter("Sun, 01 Mar 2020 12:00:30 GMT", now))
+	assert.Equal(t, time.Duration(0), parseRetryAfter("Sun, 01 Mar 2020 11:00:00 GMT", now))
+	assert.Equal(t, time.Duration(0), parseRetryAfter("soon", now))
+	assert.Equal(t, time.Duration(0), parseRetryAfter("", now))
+}
+
+func TestRequest_Execute_decodeErrors(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/invalid":
+			w.WriteHeader(http.StatusBadRequest)
+			_, _ = w.Write([]byte(`{"error":"invalid address"}`))
+		case "/down":
+			w.WriteHeader(http.StatusBadGateway)
+			_, _ = w.Write([]byte("<html>Bad Gateway</html>"))
+		}
+	}))
+	defer server.Close()
+
+	defer func(retries uint64) { UpstreamRetries = retries }(UpstreamRetries)
+	UpstreamRetries = 0
+
+	client := InitClient(server.URL)
+	client.DecodeErrors = true
+
+	var result map[string]string
+	assert.Nil(t, client.Get(&result, "invalid", nil))
+	assert.Equal(t, "invalid address", result["error"])
+
+	// The error pages which aren't JSON are still classified
+	upstreamErr := AsUpstreamError(client.Get(&result, "down", nil))
+	if assert.NotNil(t, upstreamErr) {
+		assert.Equal(t, UpstreamUnavailable, upstreamErr.Kind)
+	}
+}
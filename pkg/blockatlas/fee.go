@@ -0,0 +1,55 @@
+package blockatlas
+
+import "math/big"
+
+const (
+	// FeeUnitGas fees are prices of a unit of gas
+	FeeUnitGas FeeUnit = "gas"
+	// FeeUnitByte fees are prices of a byte of the transaction
+	FeeUnitByte FeeUnit = "byte"
+	// FeeUnitTx fees are flat prices of a transaction
+	FeeUnitTx FeeUnit = "tx"
+)
+
+type (
+	FeeUnit string
+
+	// FeeRequest describes the transaction to estimate, all the fields are optional
+	FeeRequest struct {
+		From  string
+		To    string
+		Value string
+		Data  string
+	}
+
+	// Fee contains the fee estimates in the smallest unit of the coin per Unit
+	Fee struct {
+		Coin     uint    `json:"coin"`
+		Unit     FeeUnit `json:"unit"`
+		Fast     string  `json:"fast"`
+		Normal   string  `json:"normal"`
+		Slow     string  `json:"slow"`
+		GasLimit string  `json:"gas_limit,omitempty"`
+	}
+)
+
+// StaticFee returns the same fee for all the tiers, for chains with fixed fees
+func StaticFee(coin uint, unit FeeUnit, fee string) *Fee {
+	return &Fee{Coin: coin, Unit: unit, Fast: fee, Normal: fee, Slow: fee}
+}
+
+// NewGasPriceFee uses the node gas price as the normal tier, fast pays 25% more and slow 20% less
+func NewGasPriceFee(coin uint, gasPrice *big.Int) *Fee {
+	return &Fee{
+		Coin:   coin,
+		Unit:   FeeUnitGas,
+		Fast:   percentOf(gasPrice, 125).String(),
+		Normal: gasPrice.String(),
+		Slow:   percentOf(gasPrice, 80).String(),
+	}
+}
+
+func percentOf(value *big.Int, percent int64) *big.Int {
+	result := new(big.Int).Mul(value, big.NewInt(percent))
+	return result.Div(result, big.NewInt(100))
+}
@@ -0,0 +1,17 @@
+package blockatlas
+
+import (
+	"github.com/stretchr/testify/assert"
+	"math/big"
+	"testing"
+)
+
+func TestNewGasPriceFee(t *testing.T) {
+	fee := NewGasPriceFee(60, big.NewInt(20000000000))
+	assert.Equal(t, &Fee{Coin: 60, Unit: FeeUnitGas, Fast: "25000000000", Normal: "20000000000", Slow: "16000000000"}, fee)
+}
+
+func TestStaticFee(t *testing.T) {
+	fee := StaticFee(714, FeeUnitTx, "37500")
+	assert.Equal(t, &Fee{Coin: 714, Unit: FeeUnitTx, Fast: "37500", Normal: "37500", Slow: "37500"}, fee)
+}
@@ -1,6 +1,7 @@
 package blockatlas
 
 import (
+	"context"
 	"encoding/json"
 	"github.com/trustwallet/blockatlas/pkg/errors"
 )
@@ -44,9 +45,14 @@ func (r *RpcResponse) GetObject(toType interface{}) error {
 }
 
 func (r *Request) RpcCall(result interface{}, method string, params interface{}) error {
+	return r.RpcCallWithContext(context.Background(), result, method, params)
+}
+
+// RpcCallWithContext is RpcCall aborting the request when the context is done
+func (r *Request) RpcCallWithContext(ctx context.Context, result interface{}, method string, params interface{}) error {
 	req := &RpcRequest{JsonRpc: JsonRpcVersion, Method: method, Params: params, Id: method}
 	var resp *RpcResponse
-	err := r.Post(&resp, "", req)
+	err := r.PostWithContext(ctx, &resp, "", req)
 	if err != nil {
 		return err
 	}
@@ -0,0 +1,41 @@
+package blockatlas
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"strconv"
+	"time"
+)
+
+// upstreamDuration measures the calls of Request.Execute by the platform and the upstream host
+var upstreamDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+	Namespace: "blockatlas",
+	Subsystem: "upstream",
+	Name:      "request_duration_seconds",
+	Help:      "Latency of the platform upstream calls by platform, host, method and status, the status is \"error\" if no response was received",
+}, []string{"platform", "host", "method", "status"})
+
+var (
+	// upstreamCircuitOpen is 1 while the circuit breaker of the upstream host fails the requests fast
+	upstreamCircuitOpen = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: "blockatlas",
+		Subsystem: "upstream",
+		Name:      "circuit_open",
+		Help:      "Whether the circuit breaker of the upstream host is open",
+	}, []string{"host"})
+
+	upstreamRetries = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "blockatlas",
+		Subsystem: "upstream",
+		Name:      "retries_total",
+		Help:      "Retries of the idempotent platform upstream calls by platform and the host of the primary endpoint",
+	}, []string{"platform", "host"})
+)
+
+func observeUpstream(platform, host, method string, status int, start time.Time) {
+	label := "error"
+	if status != 0 {
+		label = strconv.Itoa(status)
+	}
+	upstreamDuration.WithLabelValues(platform, host, method, label).Observe(time.Since(start).Seconds())
+}
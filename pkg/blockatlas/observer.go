@@ -1,6 +1,9 @@
 package blockatlas
 
-import "strconv"
+import (
+	"encoding/json"
+	"strconv"
+)
 
 type Subscriptions map[string][]string
 
@@ -11,6 +14,17 @@ type SubscriptionEvent struct {
 	OldSubscriptions Subscriptions         `json:"old_subscriptions"`
 	GUID             string                `json:"guid"`
 	Operation        SubscriptionOperation `json:"operation"`
+	// Webhook is the callback URL of the GUID when the observer delivers the events over HTTP
+	Webhook       string `json:"webhook,omitempty"`
+	WebhookSecret string `json:"webhook_secret,omitempty"`
+}
+
+// Webhook is the callback URL the observer events of a GUID are posted to,
+// the body is signed with the secret or the default observer secret if empty
+type Webhook struct {
+	GUID   string `json:"guid"`
+	URL    string `json:"url"`
+	Secret string `json:"secret,omitempty"`
 }
 
 type CoinStatus struct {
@@ -40,3 +54,26 @@ func (e *SubscriptionEvent) ParseSubscriptions(s Subscriptions) []Subscription {
 	}
 	return subs
 }
+
+// MakeSubscriptions groups the subscriptions by coin in the format of the subscription events
+func MakeSubscriptions(subs []Subscription) Subscriptions {
+	result := make(Subscriptions)
+	for _, sub := range subs {
+		coin := strconv.Itoa(int(sub.Coin))
+		result[coin] = append(result[coin], sub.Address)
+	}
+	return result
+}
+
+// OutboxEvent is a dispatched observer event kept in the storage until its delivery is confirmed.
+// Dead events have failed all the delivery attempts and wait in the dead letters to be replayed
+type OutboxEvent struct {
+	ID       string          `json:"id"`
+	GUID     string          `json:"guid"`
+	Body     json.RawMessage `json:"body"`
+	Attempts int             `json:"attempts"`
+	Dead     bool            `json:"dead,omitempty"`
+	Error    string          `json:"error,omitempty"`
+	Created  int64           `json:"created"`
+	Updated  int64           `json:"updated"`
+}
@@ -69,3 +69,22 @@ func Test_parseSubscriptions(t *testing.T) {
 		})
 	}
 }
+
+func TestMakeSubscriptions(t *testing.T) {
+	subs := []Subscription{
+		{Coin: 0, Address: "a", GUID: "guid"},
+		{Coin: 60, Address: "b", GUID: "guid"},
+		{Coin: 0, Address: "c", GUID: "guid"},
+	}
+	result := MakeSubscriptions(subs)
+	assert.Equal(t, Subscriptions{"0": {"a", "c"}, "60": {"b"}}, result)
+
+	event := SubscriptionEvent{GUID: "guid"}
+	parsed := event.ParseSubscriptions(result)
+	sort.Slice(parsed, func(i, j int) bool { return parsed[i].Address < parsed[j].Address })
+	assert.Equal(t, subs[0], parsed[0])
+	assert.Equal(t, subs[1], parsed[1])
+	assert.Equal(t, subs[2], parsed[2])
+
+	assert.Equal(t, Subscriptions{}, MakeSubscriptions(nil))
+}
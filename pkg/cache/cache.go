@@ -0,0 +1,168 @@
+package cache
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/trustwallet/blockatlas/pkg/logger"
+	"github.com/trustwallet/blockatlas/pkg/storage/util"
+	"sync"
+	"time"
+)
+
+type (
+	// Backend stores the entries of the cache, a missing entry is util.ErrNotFound.
+	// The memory backend is per process, the Redis backend is shared by the replicas
+	Backend interface {
+		Get(key string) (*Entry, error)
+		Set(key string, entry *Entry, ttl time.Duration) error
+		Delete(key string) error
+	}
+
+	Entry struct {
+		Value []byte `json:"value"`
+		// Expires is the time after which the entry is stale
+		Expires time.Time `json:"expires"`
+	}
+
+	// Locker is implemented by the backends shared by the replicas, so a single replica loads a key
+	Locker interface {
+		// Lock acquires the lock of the key for ttl at most, ok is false while another replica holds it
+		Lock(key string, ttl time.Duration) (unlock func(), ok bool, err error)
+	}
+
+	// Loader computes the value of a missing or stale entry
+	Loader func() ([]byte, error)
+)
+
+var (
+	// LockTimeout bounds the lock of a key held by a replica and the wait of the other replicas for its value
+	LockTimeout = 10 * time.Second
+	// lockPollInterval is how often the replicas waiting for a locked key look for its value
+	lockPollInterval = 50 * time.Millisecond
+
+	backendMu sync.RWMutex
+	backend   Backend = NewMemoryBackend()
+
+	calls = &group{}
+
+	requests = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "blockatlas",
+		Subsystem: "cache",
+		Name:      "requests_total",
+		Help:      "Cache lookups by result: hit, stale or miss, and the failed background refreshes of the stale entries",
+	}, []string{"result"})
+)
+
+// SetBackend replaces the backend of the cache, the memory backend is used by default
+func SetBackend(b Backend) {
+	backendMu.Lock()
+	defer backendMu.Unlock()
+	backend = b
+}
+
+func getBackend() Backend {
+	backendMu.RLock()
+	defer backendMu.RUnlock()
+	return backend
+}
+
+// Fetch returns the value of the key, computed by load when it is missing. The concurrent misses
+// of a key wait for a single call of load. A stale entry is still served for another ttl while
+// refresh (or load if refresh is nil) computes the new value in the background, once at a time.
+// With a shared backend the replicas also take a lock, so only one of them loads or refreshes a key
+func Fetch(key string, ttl time.Duration, load, refresh Loader) ([]byte, error) {
+	entry, err := getBackend().Get(key)
+	if err != nil && err != util.ErrNotFound {
+		logger.Error(err, "Cache lookup failed", logger.Params{"key": key})
+	}
+	if err == nil {
+		if time.Now().Before(entry.Expires) {
+			requests.WithLabelValues("hit").Inc()
+			return entry.Value, nil
+		}
+		requests.WithLabelValues("stale").Inc()
+		if refresh == nil {
+			refresh = load
+		}
+		calls.start(key, func() ([]byte, error) {
+			unlock, ok := lock(key)
+			// Another replica is refreshing the entry
+			if !ok {
+				return nil, nil
+			}
+			defer unlock()
+			value, err := store(key, ttl, refresh)
+			// Nobody waits for the refresh, the stale entry is served until it expires
+			if err != nil {
+				requests.WithLabelValues("refresh_error").Inc()
+				logger.Error(err, "Cache refresh failed", logger.Params{"key": key})
+			}
+			return value, err
+		})
+		return entry.Value, nil
+	}
+	requests.WithLabelValues("miss").Inc()
+	return calls.do(key, func() ([]byte, error) {
+		unlock, ok := lock(key)
+		if !ok {
+			if value, found := wait(key); found {
+				return value, nil
+			}
+			// The replica holding the lock failed, the value is loaded without it
+			return store(key, ttl, load)
+		}
+		defer unlock()
+		return store(key, ttl, load)
+	})
+}
+
+// lock takes the lock of the key if the backend is shared, ok is false if another replica holds it.
+// The lock is not required to load a value, a failure of the backend is only logged
+func lock(key string) (unlock func(), ok bool) {
+	locker, shared := getBackend().(Locker)
+	if !shared {
+		return func() {}, true
+	}
+	unlock, ok, err := locker.Lock(key, LockTimeout)
+	if err != nil {
+		logger.Error(err, "Cache lock failed", logger.Params{"key": key})
+		return func() {}, true
+	}
+	return unlock, ok
+}
+
+// wait polls the backend for the value of the key loaded by another replica until LockTimeout
+func wait(key string) ([]byte, bool) {
+	deadline := time.Now().Add(LockTimeout)
+	for time.Now().Before(deadline) {
+		time.Sleep(lockPollInterval)
+		entry, err := getBackend().Get(key)
+		if err == nil {
+			return entry.Value, true
+		}
+		if err != util.ErrNotFound {
+			return nil, false
+		}
+	}
+	return nil, false
+}
+
+// Delete removes the entry of the key
+func Delete(key string) {
+	if err := getBackend().Delete(key); err != nil {
+		logger.Error(err, "Cache delete failed", logger.Params{"key": key})
+	}
+}
+
+func store(key string, ttl time.Duration, load Loader) ([]byte, error) {
+	value, err := load()
+	if err != nil {
+		return nil, err
+	}
+	entry := &Entry{Value: value, Expires: time.Now().Add(ttl)}
+	// The entry is kept twice as long as it is fresh to be served while it is refreshed
+	if err := getBackend().Set(key, entry, ttl*2); err != nil {
+		logger.Error(err, "Cache store failed", logger.Params{"key": key})
+	}
+	return value, nil
+}
@@ -0,0 +1,163 @@
+package cache
+
+import (
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/trustwallet/blockatlas/pkg/errors"
+	"github.com/trustwallet/blockatlas/pkg/storage/util"
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func counter(calls *int32, delay time.Duration) Loader {
+	return func() ([]byte, error) {
+		n := atomic.AddInt32(calls, 1)
+		time.Sleep(delay)
+		return []byte(strconv.Itoa(int(n))), nil
+	}
+}
+
+func TestFetch(t *testing.T) {
+	SetBackend(NewMemoryBackend())
+	var calls int32
+	load := counter(&calls, 0)
+
+	value, err := Fetch("fetch", time.Hour, load, nil)
+	assert.Nil(t, err)
+	assert.Equal(t, "1", string(value))
+
+	value, err = Fetch("fetch", time.Hour, load, nil)
+	assert.Nil(t, err)
+	assert.Equal(t, "1", string(value))
+	assert.Equal(t, int32(1), atomic.LoadInt32(&calls))
+
+	Delete("fetch")
+	value, _ = Fetch("fetch", time.Hour, load, nil)
+	assert.Equal(t, "2", string(value))
+}
+
+func TestFetch_concurrentMisses(t *testing.T) {
+	SetBackend(NewMemoryBackend())
+	var calls int32
+	load := counter(&calls, 50*time.Millisecond)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			value, err := Fetch("misses", time.Hour, load, nil)
+			assert.Nil(t, err)
+			assert.Equal(t, "1", string(value))
+		}()
+	}
+	wg.Wait()
+	assert.Equal(t, int32(1), atomic.LoadInt32(&calls))
+}
+
+func TestFetch_stale(t *testing.T) {
+	backend := NewMemoryBackend()
+	SetBackend(backend)
+	err := backend.Set("stale", &Entry{Value: []byte("old"), Expires: time.Now().Add(-time.Second)}, time.Hour)
+	assert.Nil(t, err)
+
+	var calls int32
+	load := counter(&calls, 50*time.Millisecond)
+
+	// The stale value is served while a single refresh runs
+	for i := 0; i < 3; i++ {
+		value, err := Fetch("stale", time.Hour, load, nil)
+		assert.Nil(t, err)
+		assert.Equal(t, "old", string(value))
+	}
+	time.Sleep(100 * time.Millisecond)
+	assert.Equal(t, int32(1), atomic.LoadInt32(&calls))
+
+	value, _ := Fetch("stale", time.Hour, load, nil)
+	assert.Equal(t, "1", string(value))
+}
+
+func TestFetch_staleRefreshError(t *testing.T) {
+	backend := NewMemoryBackend()
+	SetBackend(backend)
+	err := backend.Set("failing", &Entry{Value: []byte("old"), Expires: time.Now().Add(-time.Second)}, time.Hour)
+	assert.Nil(t, err)
+
+	failures := testutil.ToFloat64(requests.WithLabelValues("refresh_error"))
+	refresh := func() ([]byte, error) {
+		return nil, errors.E("upstream failed")
+	}
+	value, err := Fetch("failing", time.Hour, nil, refresh)
+	assert.Nil(t, err)
+	assert.Equal(t, "old", string(value))
+	time.Sleep(50 * time.Millisecond)
+	assert.Equal(t, failures+1, testutil.ToFloat64(requests.WithLabelValues("refresh_error")))
+
+	// The stale entry is still served
+	value, err = Fetch("failing", time.Hour, nil, refresh)
+	assert.Nil(t, err)
+	assert.Equal(t, "old", string(value))
+}
+
+// lockedBackend is a shared backend whose locks are held by another replica
+type lockedBackend struct {
+	Backend
+}
+
+func (lockedBackend) Lock(key string, ttl time.Duration) (func(), bool, error) {
+	return nil, false, nil
+}
+
+func TestFetch_locked(t *testing.T) {
+	backend := lockedBackend{NewMemoryBackend()}
+	SetBackend(backend)
+	defer SetBackend(NewMemoryBackend())
+
+	var calls int32
+	load := counter(&calls, 0)
+
+	// The miss waits for the value loaded by the replica holding the lock
+	go func() {
+		time.Sleep(100 * time.Millisecond)
+		_ = backend.Set("locked", &Entry{Value: []byte("replica"), Expires: time.Now().Add(time.Hour)}, time.Hour)
+	}()
+	value, err := Fetch("locked", time.Hour, load, nil)
+	assert.Nil(t, err)
+	assert.Equal(t, "replica", string(value))
+
+	// The stale entry is refreshed by the replica holding the lock
+	err = backend.Set("locked", &Entry{Value: []byte("old"), Expires: time.Now().Add(-time.Second)}, time.Hour)
+	assert.Nil(t, err)
+	value, _ = Fetch("locked", time.Hour, load, nil)
+	assert.Equal(t, "old", string(value))
+	time.Sleep(50 * time.Millisecond)
+	assert.Equal(t, int32(0), atomic.LoadInt32(&calls))
+}
+
+func TestGroup_panic(t *testing.T) {
+	g := &group{}
+	assert.Panics(t, func() {
+		_, _ = g.do("panic", func() ([]byte, error) {
+			panic("load failed")
+		})
+	})
+	value, err := g.do("panic", func() ([]byte, error) {
+		return []byte("ok"), nil
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, "ok", string(value))
+}
+
+func TestMemoryBackend(t *testing.T) {
+	backend := NewMemoryBackend()
+	_, err := backend.Get("missing")
+	assert.Equal(t, util.ErrNotFound, err)
+
+	assert.Nil(t, backend.Set("key", &Entry{Value: []byte("value")}, time.Hour))
+	entry, err := backend.Get("key")
+	assert.Nil(t, err)
+	assert.Equal(t, "value", string(entry.Value))
+}
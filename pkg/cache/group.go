@@ -0,0 +1,67 @@
+package cache
+
+import (
+	"github.com/trustwallet/blockatlas/pkg/errors"
+	"sync"
+)
+
+type (
+	// group collapses the concurrent calls of a key into a single one
+	group struct {
+		mu    sync.Mutex
+		calls map[string]*call
+	}
+
+	call struct {
+		wg    sync.WaitGroup
+		value []byte
+		err   error
+	}
+)
+
+// do calls fn unless a call of the key is in flight, the callers get the result of the same call
+func (g *group) do(key string, fn Loader) ([]byte, error) {
+	g.mu.Lock()
+	if c, ok := g.calls[key]; ok {
+		g.mu.Unlock()
+		c.wg.Wait()
+		return c.value, c.err
+	}
+	c := g.add(key)
+	g.mu.Unlock()
+
+	g.run(key, c, fn)
+	return c.value, c.err
+}
+
+// start calls fn in the background unless a call of the key is in flight
+func (g *group) start(key string, fn Loader) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	if _, ok := g.calls[key]; ok {
+		return
+	}
+	c := g.add(key)
+	go g.run(key, c, fn)
+}
+
+func (g *group) add(key string) *call {
+	if g.calls == nil {
+		g.calls = make(map[string]*call)
+	}
+	c := &call{err: errors.E("cache: the value could not be computed", errors.Params{"key": key})}
+	c.wg.Add(1)
+	g.calls[key] = c
+	return c
+}
+
+// run releases the waiting callers even if fn panics, they get an error then
+func (g *group) run(key string, c *call, fn Loader) {
+	defer func() {
+		g.mu.Lock()
+		delete(g.calls, key)
+		g.mu.Unlock()
+		c.wg.Done()
+	}()
+	c.value, c.err = fn()
+}
@@ -0,0 +1,35 @@
+package cache
+
+import (
+	gocache "github.com/patrickmn/go-cache"
+	"github.com/trustwallet/blockatlas/pkg/storage/util"
+	"time"
+)
+
+type memoryBackend struct {
+	cache *gocache.Cache
+}
+
+// NewMemoryBackend creates a backend in the memory of the process
+func NewMemoryBackend() Backend {
+	return &memoryBackend{cache: gocache.New(5*time.Minute, 5*time.Minute)}
+}
+
+func (m *memoryBackend) Get(key string) (*Entry, error) {
+	value, ok := m.cache.Get(key)
+	if !ok {
+		return nil, util.ErrNotFound
+	}
+	entry := value.(Entry)
+	return &entry, nil
+}
+
+func (m *memoryBackend) Set(key string, entry *Entry, ttl time.Duration) error {
+	m.cache.Set(key, *entry, ttl)
+	return nil
+}
+
+func (m *memoryBackend) Delete(key string) error {
+	m.cache.Delete(key)
+	return nil
+}
@@ -0,0 +1,100 @@
+package cache
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"github.com/go-redis/redis"
+	"github.com/trustwallet/blockatlas/pkg/errors"
+	"github.com/trustwallet/blockatlas/pkg/logger"
+	"github.com/trustwallet/blockatlas/pkg/storage/util"
+	"time"
+)
+
+const (
+	// redisPrefix keeps the cache entries apart from the other keys of the database
+	redisPrefix = "cache:"
+	// redisLockPrefix is the prefix of the keys locked by a replica while it loads them
+	redisLockPrefix = "cache-lock:"
+)
+
+// unlockScript deletes the lock only if it is still held with the token, it may have expired
+// and been taken by another replica meanwhile
+var unlockScript = redis.NewScript(`
+if redis.call('GET', KEYS[1]) == ARGV[1] then
+	return redis.call('DEL', KEYS[1])
+end
+return 0
+`)
+
+type redisBackend struct {
+	client *redis.Client
+}
+
+// NewRedisBackend connects to the Redis database shared by the replicas
+func NewRedisBackend(host string) (Backend, error) {
+	options, err := redis.ParseURL(host)
+	if err != nil {
+		return nil, errors.E(err, "Cannot connect to Redis")
+	}
+	client := redis.NewClient(options)
+	if err := client.Ping().Err(); err != nil {
+		return nil, errors.E(err, "Redis connection test failed")
+	}
+	return &redisBackend{client: client}, nil
+}
+
+func (r *redisBackend) Get(key string) (*Entry, error) {
+	b, err := r.client.Get(redisPrefix + key).Bytes()
+	if err == redis.Nil {
+		return nil, util.ErrNotFound
+	}
+	if err != nil {
+		return nil, errors.E(err, errors.Params{"key": key})
+	}
+	var entry Entry
+	if err := json.Unmarshal(b, &entry); err != nil {
+		return nil, errors.E(err, errors.Params{"key": key})
+	}
+	return &entry, nil
+}
+
+func (r *redisBackend) Set(key string, entry *Entry, ttl time.Duration) error {
+	b, err := json.Marshal(entry)
+	if err != nil {
+		return errors.E(err, errors.Params{"key": key})
+	}
+	if err := r.client.Set(redisPrefix+key, b, ttl).Err(); err != nil {
+		return errors.E(err, util.ErrNotStored, errors.Params{"key": key})
+	}
+	return nil
+}
+
+func (r *redisBackend) Delete(key string) error {
+	if err := r.client.Del(redisPrefix + key).Err(); err != nil {
+		return errors.E(err, util.ErrNotDeleted, errors.Params{"key": key})
+	}
+	return nil
+}
+
+// Lock sets the lock key with SET NX and a random token, it expires after ttl if it is not released
+func (r *redisBackend) Lock(key string, ttl time.Duration) (func(), bool, error) {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return nil, false, errors.E(err, errors.Params{"key": key})
+	}
+	token := hex.EncodeToString(b)
+	ok, err := r.client.SetNX(redisLockPrefix+key, token, ttl).Result()
+	if err != nil {
+		return nil, false, errors.E(err, errors.Params{"key": key})
+	}
+	if !ok {
+		return nil, false, nil
+	}
+	unlock := func() {
+		if err := unlockScript.Run(r.client, []string{redisLockPrefix + key}, token).Err(); err != nil && err != redis.Nil {
+			logger.Error(err, "Cache unlock failed", logger.Params{"key": key})
+		}
+	}
+	return unlock, true, nil
+}
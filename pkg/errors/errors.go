@@ -17,6 +17,8 @@ type (
 		Type  Type
 		meta  map[string]interface{}
 		stack []string
+		// cause is the first error the error was created from, it keeps the chain of the wrapped errors
+		cause error
 	}
 )
 
@@ -48,6 +50,12 @@ func (e *Error) String() string {
 	return msg
 }
 
+// Unwrap returns the error the error was created from, so errors.Is and errors.As
+// of the standard library find the errors wrapped by E
+func (e *Error) Unwrap() error {
+	return e.cause
+}
+
 // SetMeta sets the error's meta data.
 func (e *Error) SetMeta(data Params) *Error {
 	e.meta = data
@@ -115,8 +123,14 @@ func E(args ...interface{}) *Error {
 		case *Error:
 			message = append([]string{arg.Err.Error()}, message...)
 			appendMap(e.meta, arg.meta)
+			if e.cause == nil {
+				e.cause = arg
+			}
 		case error:
 			message = append([]string{arg.Error()}, message...)
+			if e.cause == nil {
+				e.cause = arg
+			}
 		case Type:
 			e.Type = arg
 		case Params:
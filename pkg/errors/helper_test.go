@@ -1,6 +1,7 @@
 package errors
 
 import (
+	"errors"
 	"fmt"
 	"testing"
 )
@@ -14,6 +15,7 @@ func TestIsType(t *testing.T) {
 		{fmt.Errorf("test"), TypePlatformRequest, false},
 		{&Error{Type: TypePlatformRequest}, TypePlatformRequest, true},
 		{&Error{Type: TypePlatformUnmarshal}, TypePlatformRequest, false},
+		{E(E(fmt.Errorf("test"), TypePlatformRequest), "wrapped"), TypePlatformRequest, true},
 	}
 	for i, tt := range tests {
 		t.Run(fmt.Sprintf("TestIsType %d", i), func(t *testing.T) {
@@ -45,3 +47,14 @@ func TestEqual(t *testing.T) {
 		})
 	}
 }
+
+func TestUnwrap(t *testing.T) {
+	cause := fmt.Errorf("cause")
+	err := E(E(cause, TypePlatformRequest), "wrapped", Params{"key": "value"})
+	if !errors.Is(err, cause) {
+		t.Error("the cause is not in the chain")
+	}
+	if err.Error() != `{"error":"cause: wrapped","meta":{"key":"value"}}` {
+		t.Errorf("unexpected message %s", err.Error())
+	}
+}
@@ -10,8 +10,11 @@ func Is(err error, t Type) bool {
 	if e.Type != TypeNone {
 		return e.Type == t
 	}
-	if e.Err != nil {
-		return Is(e.Err, t)
+	if e.Err != nil && Is(e.Err, t) {
+		return true
+	}
+	if e.cause != nil {
+		return Is(e.cause, t)
 	}
 	return false
 }
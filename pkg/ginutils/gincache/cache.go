@@ -2,33 +2,23 @@ package gincache
 
 import (
 	"bytes"
+	"context"
 	"crypto/sha1"
 	"encoding/base64"
 	"encoding/json"
-	"fmt"
 	"github.com/gin-gonic/gin"
-	"github.com/patrickmn/go-cache"
+	"github.com/trustwallet/blockatlas/pkg/cache"
 	"github.com/trustwallet/blockatlas/pkg/errors"
 	"github.com/trustwallet/blockatlas/pkg/logger"
-	"github.com/trustwallet/blockatlas/pkg/storage/util"
 	"io/ioutil"
 	"net/http"
-	"sync"
 	"time"
 )
 
-var (
-	memoryCache *memCache
-)
+// keyPrefix keeps the cached routes apart from the other entries of the cache
+const keyPrefix = "route:"
 
-func init() {
-	memoryCache = &memCache{cache: cache.New(5*time.Minute, 5*time.Minute)}
-}
-
-type memCache struct {
-	sync.RWMutex
-	cache *cache.Cache
-}
+var errNotCacheable = errors.E("gin-cache: the response is not cacheable")
 
 type cacheResponse struct {
 	Status int
@@ -36,32 +26,16 @@ type cacheResponse struct {
 	Data   []byte
 }
 
+// cachedWriter records the response written by a handler
 type cachedWriter struct {
 	gin.ResponseWriter
-	status  int
-	written bool
-	expire  time.Duration
-	key     string
+	data bytes.Buffer
 }
 
 var _ gin.ResponseWriter = &cachedWriter{}
 
-func newCachedWriter(expire time.Duration, writer gin.ResponseWriter, key string) *cachedWriter {
-	return &cachedWriter{writer, 0, false, expire, key}
-}
-
-func (w *cachedWriter) WriteHeader(code int) {
-	w.status = code
-	w.written = true
-	w.ResponseWriter.WriteHeader(code)
-}
-
-func (w *cachedWriter) Status() int {
-	return w.ResponseWriter.Status()
-}
-
-func (w *cachedWriter) Written() bool {
-	return w.ResponseWriter.Written()
+func newCachedWriter(writer gin.ResponseWriter) *cachedWriter {
+	return &cachedWriter{ResponseWriter: writer}
 }
 
 func (w *cachedWriter) Write(data []byte) (int, error) {
@@ -69,19 +43,7 @@ func (w *cachedWriter) Write(data []byte) (int, error) {
 	if err != nil {
 		return 0, errors.E(err, "fail to cache write string", errors.Params{"data": data})
 	}
-	if w.Status() != 200 {
-		return 0, errors.E("Write: invalid cache status", errors.Params{"data": data})
-	}
-	val := cacheResponse{
-		w.Status(),
-		w.Header(),
-		data,
-	}
-	b, err := json.Marshal(val)
-	if err != nil {
-		return 0, errors.E("validator cache: failed to marshal cache object")
-	}
-	memoryCache.cache.Set(w.key, b, w.expire)
+	w.data.Write(data)
 	return ret, nil
 }
 
@@ -90,57 +52,63 @@ func (w *cachedWriter) WriteString(data string) (n int, err error) {
 	if err != nil {
 		return 0, errors.E(err, "fail to cache write string", errors.Params{"data": data})
 	}
-	if w.Status() != 200 {
-		return 0, errors.E("WriteString: invalid cache status", errors.Params{"data": data})
-	}
-	val := cacheResponse{
-		w.Status(),
-		w.Header(),
-		[]byte(data),
+	w.data.WriteString(data)
+	return ret, nil
+}
+
+// response returns the recorded response, only the successful ones are cached
+func (w *cachedWriter) response(c *gin.Context) ([]byte, error) {
+	if c.IsAborted() || w.Status() != http.StatusOK {
+		return nil, errNotCacheable
 	}
-	b, err := json.Marshal(val)
+	b, err := json.Marshal(cacheResponse{
+		Status: w.Status(),
+		Header: w.Header(),
+		Data:   w.data.Bytes(),
+	})
 	if err != nil {
-		return 0, errors.E("validator cache: failed to marshal cache object")
+		return nil, errors.E(err, "gin-cache: failed to marshal cache object")
 	}
-	memoryCache.setCache(w.key, b, w.expire)
-	return ret, err
+	return b, nil
 }
 
-func (mc *memCache) deleteCache(key string) {
-	mc.RLock()
-	defer mc.RUnlock()
-	memoryCache.cache.Delete(key)
+// render runs the handler with a recorder of its response
+func render(c *gin.Context, handle gin.HandlerFunc) ([]byte, error) {
+	writer := newCachedWriter(c.Writer)
+	c.Writer = writer
+	handle(c)
+	return writer.response(c)
 }
 
-func (mc *memCache) setCache(k string, x interface{}, d time.Duration) {
-	b, err := json.Marshal(x)
-	if err != nil {
-		logger.Error(errors.E(err, "client cache cannot marshal cache object"))
-		return
+// detach copies the request to run the handler again once it is over, the response is discarded.
+// The copy is taken right away because gin reuses the context of a request which is over
+func detach(c *gin.Context, body []byte) func() *gin.Context {
+	req := c.Request.Clone(context.Background())
+	params := append(gin.Params(nil), c.Params...)
+	return func() *gin.Context {
+		d, _ := gin.CreateTestContext(discardWriter{header: make(http.Header)})
+		d.Request = req
+		d.Request.Body = ioutil.NopCloser(bytes.NewReader(body))
+		d.Params = params
+		return d
 	}
-	mc.RLock()
-	defer mc.RUnlock()
-	memoryCache.cache.Set(k, b, d)
 }
 
-func (mc *memCache) getCache(key string) (cacheResponse, error) {
-	var result cacheResponse
-	c, ok := mc.cache.Get(key)
-	if !ok {
-		return result, fmt.Errorf("gin-cache: invalid cache key %s", key)
-	}
-	r, ok := c.([]byte)
-	if !ok {
-		return result, errors.E("validator cache: failed to cast cache to bytes")
-	}
-	err := json.Unmarshal(r, &result)
-	if err != nil {
-		return result, errors.E(err, util.ErrNotFound)
-	}
-	return result, nil
+type discardWriter struct {
+	header http.Header
+}
+
+func (w discardWriter) Header() http.Header {
+	return w.header
 }
 
-func generateKey(c *gin.Context) string {
+func (w discardWriter) Write(data []byte) (int, error) {
+	return len(data), nil
+}
+
+func (w discardWriter) WriteHeader(int) {}
+
+func generateKey(c *gin.Context) (string, []byte) {
 	url := c.Request.URL.String()
 	var b []byte
 	if c.Request.Body != nil {
@@ -149,23 +117,34 @@ func generateKey(c *gin.Context) string {
 		c.Request.Body = ioutil.NopCloser(bytes.NewBuffer(b))
 	}
 	hash := sha1.Sum(append([]byte(url), b...))
-	return base64.URLEncoding.EncodeToString(hash[:])
+	return keyPrefix + base64.URLEncoding.EncodeToString(hash[:]), b
 }
 
 // CacheMiddleware encapsulates a gin handler function and caches the response with an expiration time.
+// The concurrent requests of a missing response wait for a single call of the handler, and an expired
+// response is still served for the same duration while a copy of the request refreshes it
 func CacheMiddleware(expiration time.Duration, handle gin.HandlerFunc) gin.HandlerFunc {
 	return func(c *gin.Context) {
 		defer c.Next()
-		key := generateKey(c)
-		mc, err := memoryCache.getCache(key)
-		if err != nil || mc.Data == nil {
-			writer := newCachedWriter(expiration, c.Writer, key)
-			c.Writer = writer
+		key, body := generateKey(c)
+		detached := detach(c, body)
+		served := false
+		b, err := cache.Fetch(key, expiration, func() ([]byte, error) {
+			served = true
+			return render(c, handle)
+		}, func() ([]byte, error) {
+			return render(detached(), handle)
+		})
+		if served {
+			return
+		}
+		var mc cacheResponse
+		if err == nil {
+			err = json.Unmarshal(b, &mc)
+		}
+		if err != nil {
+			// The shared call failed, the request is handled on its own
 			handle(c)
-
-			if c.IsAborted() {
-				memoryCache.deleteCache(key)
-			}
 			return
 		}
 
@@ -177,7 +156,6 @@ func CacheMiddleware(expiration time.Duration, handle gin.HandlerFunc) gin.Handl
 		}
 		_, err = c.Writer.Write(mc.Data)
 		if err != nil {
-			memoryCache.deleteCache(key)
 			logger.Error(err, "cannot write data", mc)
 		}
 	}
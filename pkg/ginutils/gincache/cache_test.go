@@ -4,8 +4,11 @@ import (
 	"fmt"
 	"github.com/gin-gonic/gin"
 	"github.com/stretchr/testify/assert"
+	"github.com/trustwallet/blockatlas/pkg/cache"
 	"github.com/trustwallet/blockatlas/pkg/ginutils"
 	"net/http/httptest"
+	"sync"
+	"sync/atomic"
 	"testing"
 	"time"
 )
@@ -25,7 +28,7 @@ func TestWrite(t *testing.T) {
 	w := httptest.NewRecorder()
 	c, _ := gin.CreateTestContext(w)
 
-	writer := newCachedWriter(time.Second*3, c.Writer, "mykey")
+	writer := newCachedWriter(c.Writer)
 	c.Writer = writer
 
 	c.Writer.WriteHeader(204)
@@ -37,6 +40,7 @@ func TestWrite(t *testing.T) {
 }
 
 func TestCachePage(t *testing.T) {
+	cache.SetBackend(cache.NewMemoryBackend())
 	router := gin.New()
 	router.GET("/cache_ping", CacheMiddleware(time.Second*3, func(c *gin.Context) {
 		ginutils.RenderSuccess(c, "pong "+fmt.Sprint(time.Now().UnixNano()))
@@ -51,6 +55,7 @@ func TestCachePage(t *testing.T) {
 }
 
 func TestCachePageExpire(t *testing.T) {
+	cache.SetBackend(cache.NewMemoryBackend())
 	router := gin.New()
 	router.GET("/cache_ping", CacheMiddleware(time.Second, func(c *gin.Context) {
 		ginutils.RenderSuccess(c, "pong "+fmt.Sprint(time.Now().UnixNano()))
@@ -64,3 +69,50 @@ func TestCachePageExpire(t *testing.T) {
 	assert.Equal(t, 200, w2.Code)
 	assert.NotEqual(t, w1.Body.String(), w2.Body.String())
 }
+
+func TestCachePageStale(t *testing.T) {
+	cache.SetBackend(cache.NewMemoryBackend())
+	var calls int32
+	router := gin.New()
+	router.GET("/cache_stale/:id", CacheMiddleware(time.Second, func(c *gin.Context) {
+		atomic.AddInt32(&calls, 1)
+		ginutils.RenderSuccess(c, c.Param("id")+" "+fmt.Sprint(time.Now().UnixNano()))
+	}))
+
+	w1 := performRequest("GET", "/cache_stale/1", router)
+	time.Sleep(time.Millisecond * 1200)
+
+	// The expired response is served while a copy of the request refreshes it
+	w2 := performRequest("GET", "/cache_stale/1", router)
+	assert.Equal(t, w1.Body.String(), w2.Body.String())
+	time.Sleep(time.Millisecond * 100)
+	assert.Equal(t, int32(2), atomic.LoadInt32(&calls))
+
+	w3 := performRequest("GET", "/cache_stale/1", router)
+	assert.NotEqual(t, w1.Body.String(), w3.Body.String())
+	assert.Contains(t, w3.Body.String(), "1 ")
+}
+
+func TestCachePageConcurrent(t *testing.T) {
+	cache.SetBackend(cache.NewMemoryBackend())
+	var calls int32
+	router := gin.New()
+	router.GET("/cache_concurrent", CacheMiddleware(time.Second*3, func(c *gin.Context) {
+		atomic.AddInt32(&calls, 1)
+		time.Sleep(time.Millisecond * 100)
+		ginutils.RenderSuccess(c, "pong")
+	}))
+
+	var wg sync.WaitGroup
+	for i := 0; i < 5; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			w := performRequest("GET", "/cache_concurrent", router)
+			assert.Equal(t, 200, w.Code)
+			assert.Equal(t, `"pong"`+"\n", w.Body.String())
+		}()
+	}
+	wg.Wait()
+	assert.Equal(t, int32(1), atomic.LoadInt32(&calls))
+}
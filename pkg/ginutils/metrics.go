@@ -0,0 +1,32 @@
+package ginutils
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"strconv"
+	"time"
+)
+
+var requestDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+	Namespace: "blockatlas",
+	Subsystem: "http",
+	Name:      "request_duration_seconds",
+	Help:      "Latency of the API requests by route, method and status",
+}, []string{"route", "method", "status"})
+
+// MetricsMiddleware measures the requests by the route pattern rather than the path,
+// so the addresses and hashes in the paths don't create a series each
+func MetricsMiddleware() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		start := time.Now()
+		c.Next()
+
+		route := c.FullPath()
+		if route == "" {
+			route = "unmatched"
+		}
+		status := strconv.Itoa(c.Writer.Status())
+		requestDuration.WithLabelValues(route, c.Request.Method, status).Observe(time.Since(start).Seconds())
+	}
+}
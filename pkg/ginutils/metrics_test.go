@@ -0,0 +1,28 @@
+package ginutils
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestMetricsMiddleware(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	requestDuration.Reset()
+
+	engine := gin.New()
+	engine.Use(MetricsMiddleware())
+	engine.GET("/v1/:coin/:address", func(c *gin.Context) {
+		c.Status(http.StatusOK)
+	})
+
+	for _, path := range []string{"/v1/bitcoin/a", "/v1/bitcoin/b", "/v1/ethereum/c", "/missing"} {
+		engine.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest("GET", path, nil))
+	}
+
+	// The paths of the same route share one series
+	assert.Equal(t, 2, testutil.CollectAndCount(requestDuration))
+}
@@ -41,6 +41,9 @@ func DecimalExp(dec string, exp int) string {
 	// Remove leading zeros
 	origSize := len(dec)
 	dec = strings.TrimLeft(dec, "0")
+	if dec == "" {
+		return "0"
+	}
 	i -= origSize - len(dec)
 	// Fix bounds
 	if i <= 0 {
@@ -30,6 +30,7 @@ func TestDecimalExp(t *testing.T) {
 
 	// No-Op
 	assertEquals("0", 300, "0")
+	assertEquals("0.00000000", 8, "0")
 	assertEquals("123", 0, "123")
 	assertEquals("0.456", 0, "0.456")
 	assertEquals("123.456", 0, "123.456")
@@ -49,3 +49,40 @@ func (db *Redis) DeleteHM(entity, key string) error {
 	}
 	return nil
 }
+
+func (db *Redis) AddManyHM(entity string, values map[string]interface{}) error {
+	fields := make(map[string]interface{}, len(values))
+	for key, value := range values {
+		j, err := json.Marshal(value)
+		if err != nil {
+			return errors.E(err, errors.Params{"key": key})
+		}
+		fields[key] = j
+	}
+	cmd := db.client.HMSet(entity, fields)
+	if cmd.Err() != nil {
+		return errors.E(cmd.Err(), util.ErrNotStored)
+	}
+	return nil
+}
+
+// ScanHM iterates over the fields of the hash with HSCAN, so a large hash doesn't block the server
+func (db *Redis) ScanHM(entity string, fn func(key, value string) error) error {
+	var cursor uint64
+	for {
+		keys, next, err := db.client.HScan(entity, cursor, "", 1000).Result()
+		if err != nil {
+			return errors.E(err, util.ErrNotFound, errors.Params{"entity": entity})
+		}
+		// HSCAN returns the fields and their values in turn
+		for i := 0; i+1 < len(keys); i += 2 {
+			if err := fn(keys[i], keys[i+1]); err != nil {
+				return err
+			}
+		}
+		if next == 0 {
+			return nil
+		}
+		cursor = next
+	}
+}
@@ -0,0 +1,25 @@
+package redis
+
+import (
+	"github.com/go-redis/redis"
+	"github.com/trustwallet/blockatlas/pkg/errors"
+	"github.com/trustwallet/blockatlas/pkg/storage/util"
+)
+
+// Script is a Lua script which Redis runs atomically
+type Script struct {
+	script *redis.Script
+}
+
+func NewScript(src string) *Script {
+	return &Script{script: redis.NewScript(src)}
+}
+
+// RunScript runs the script, loading it into the server script cache if needed
+func (db *Redis) RunScript(s *Script, keys []string, args ...interface{}) error {
+	cmd := s.script.Run(db.client, keys, args...)
+	if cmd.Err() != nil && cmd.Err() != redis.Nil {
+		return errors.E(cmd.Err(), util.ErrNotUpdated, errors.Params{"keys": keys})
+	}
+	return nil
+}
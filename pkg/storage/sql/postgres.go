@@ -3,7 +3,7 @@ package sql
 import (
 	"github.com/jinzhu/gorm"
 	_ "github.com/jinzhu/gorm/dialects/postgres"
-	"github.com/trustwallet/blockatlas/pkg/logger"
+	"github.com/trustwallet/blockatlas/pkg/errors"
 	"time"
 )
 
@@ -11,20 +11,21 @@ type PgSql struct {
 	sql
 }
 
-func (db *PgSql) Init(host string) {
+func (db *PgSql) Init(host string) error {
 	client, err := gorm.Open("postgres", host)
 	if err != nil {
-		logger.Fatal(err, "postgress connection failed")
+		return errors.E(err, "Postgres connection failed")
 	}
 	client.DB().SetMaxIdleConns(20)
 	client.DB().SetMaxOpenConns(100)
 	client.DB().SetConnMaxLifetime(time.Minute)
 	client.LogMode(true)
 	db.Client = client
+	return nil
 }
 
 func (db *PgSql) IsReady() bool {
-	return db.Client != nil
+	return db.Client != nil && db.Client.DB().Ping() == nil
 }
 
 func (db *PgSql) Close() error {
@@ -0,0 +1,18 @@
+package algorand
+
+import (
+	"github.com/trustwallet/blockatlas/pkg/blockatlas"
+	"strconv"
+)
+
+func (p *Platform) GetBalance(address string) (*blockatlas.Balance, error) {
+	acc, err := p.client.GetAccount(address)
+	if err != nil {
+		return nil, err
+	}
+	return &blockatlas.Balance{
+		Coin:    p.Coin().ID,
+		Address: address,
+		Balance: strconv.FormatUint(acc.Amount, 10),
+	}, nil
+}
@@ -0,0 +1,44 @@
+package binance
+
+import (
+	"context"
+	"github.com/trustwallet/blockatlas/coin"
+	"github.com/trustwallet/blockatlas/pkg/blockatlas"
+	"github.com/trustwallet/blockatlas/pkg/numbers"
+)
+
+// All the BEP2 tokens have 8 decimals
+const bep2Decimals = 8
+
+func (p *Platform) GetBalance(address string) (*blockatlas.Balance, error) {
+	return p.GetBalanceWithContext(context.Background(), address)
+}
+
+func (p *Platform) GetBalanceWithContext(ctx context.Context, address string) (*blockatlas.Balance, error) {
+	account, err := p.dexClient.GetAccountMetadata(ctx, address)
+	if err != nil {
+		return nil, err
+	}
+	return NormalizeBalance(account.Balances, p.Coin(), address), nil
+}
+
+// NormalizeBalance converts the free balances of the account, the balances other than BNB are returned as tokens
+func NormalizeBalance(balances []Balance, c coin.Coin, address string) *blockatlas.Balance {
+	balance := blockatlas.Balance{Coin: c.ID, Address: address, Balance: "0"}
+	for _, b := range balances {
+		value := numbers.DecimalExp(b.Free, bep2Decimals)
+		if b.Symbol == c.Symbol {
+			balance.Balance = value
+			continue
+		}
+		if b.isAllZeroBalance() {
+			continue
+		}
+		balance.Tokens = append(balance.Tokens, blockatlas.TokenBalance{
+			TokenID:  b.Symbol,
+			Decimals: bep2Decimals,
+			Balance:  value,
+		})
+	}
+	return &balance
+}
@@ -0,0 +1,30 @@
+package binance
+
+import (
+	"encoding/json"
+	"github.com/stretchr/testify/assert"
+	"github.com/trustwallet/blockatlas/coin"
+	"github.com/trustwallet/blockatlas/pkg/blockatlas"
+	"testing"
+)
+
+const accountBalances = `[
+	{"free": "1.05000000", "frozen": "0.00000000", "locked": "0.00000000", "symbol": "BNB"},
+	{"free": "17199.38841739", "frozen": "0.00000000", "locked": "0.00000000", "symbol": "ARN-71B"},
+	{"free": "0.00000000", "frozen": "0.00000000", "locked": "0.00000000", "symbol": "BUSD-BD1"}
+]`
+
+func TestNormalizeBalance(t *testing.T) {
+	var balances []Balance
+	assert.Nil(t, json.Unmarshal([]byte(accountBalances), &balances))
+
+	balance := NormalizeBalance(balances, coin.Coins[coin.BNB], "bnb1jxfh2g85q3v0tdq56fnevx6xcxtcnhtsmcu64m")
+	assert.Equal(t, &blockatlas.Balance{
+		Coin:    coin.BNB,
+		Address: "bnb1jxfh2g85q3v0tdq56fnevx6xcxtcnhtsmcu64m",
+		Balance: "105000000",
+		Tokens: []blockatlas.TokenBalance{
+			{TokenID: "ARN-71B", Decimals: 8, Balance: "1719938841739"},
+		},
+	}, balance)
+}
@@ -1,15 +1,20 @@
 package binance
 
 import (
+	"context"
 	"github.com/trustwallet/blockatlas/pkg/blockatlas"
 	"github.com/trustwallet/blockatlas/pkg/errors"
 )
 
 func (p *Platform) CurrentBlockNumber() (int64, error) {
+	return p.CurrentBlockNumberWithContext(context.Background())
+}
+
+func (p *Platform) CurrentBlockNumberWithContext(ctx context.Context) (int64, error) {
 	// No native function to get height in explorer API
 	// Workaround: Request list of blocks
 	// and return number of the newest one
-	list, err := p.client.GetBlockList(1)
+	list, err := p.client.GetBlockList(ctx, 1)
 	if err != nil {
 		return 0, err
 	}
@@ -20,13 +25,17 @@ func (p *Platform) CurrentBlockNumber() (int64, error) {
 }
 
 func (p *Platform) GetBlockByNumber(num int64) (*blockatlas.Block, error) {
-	srcTxs, err := p.client.GetBlockByNumber(num)
+	return p.GetBlockByNumberWithContext(context.Background(), num)
+}
+
+func (p *Platform) GetBlockByNumberWithContext(ctx context.Context, num int64) (*blockatlas.Block, error) {
+	srcTxs, err := p.client.GetBlockByNumber(ctx, num)
 	if err != nil {
 		return nil, err
 	}
 
 	txs := make(blockatlas.TxPage, 0)
-	childTxs, err := p.getTxChildChan(srcTxs.Txs)
+	childTxs, err := p.getTxChildChan(ctx, srcTxs.Txs)
 	if err == nil {
 		txs = NormalizeTxs(childTxs, "", "")
 	} else {
@@ -0,0 +1,40 @@
+package binance
+
+import (
+	"context"
+	"encoding/json"
+	"github.com/trustwallet/blockatlas/pkg/blockatlas"
+	"github.com/trustwallet/blockatlas/pkg/errors"
+)
+
+func (p *Platform) BroadcastTransaction(raw string) (string, error) {
+	return p.BroadcastTransactionWithContext(context.Background(), raw)
+}
+
+func (p *Platform) BroadcastTransactionWithContext(ctx context.Context, raw string) (string, error) {
+	res, err := p.dexClient.BroadcastTx(ctx, raw)
+	if err != nil {
+		return "", err
+	}
+	return NormalizeBroadcastResult(res)
+}
+
+// NormalizeBroadcastResult returns the hash of the accepted transaction,
+// DEX responds with a list of results on success and with an error object otherwise
+func NormalizeBroadcastResult(res json.RawMessage) (string, error) {
+	var results []BroadcastResult
+	if err := json.Unmarshal(res, &results); err == nil {
+		if len(results) == 0 {
+			return "", errors.E("Binance: empty broadcast result")
+		}
+		if !results[0].Ok {
+			return "", blockatlas.NewBroadcastError(results[0].Log)
+		}
+		return results[0].Hash, nil
+	}
+	var sErr Error
+	if err := json.Unmarshal(res, &sErr); err != nil {
+		return "", errors.E(err, errors.TypePlatformUnmarshal, errors.Params{"result": string(res)})
+	}
+	return "", blockatlas.NewBroadcastError(sErr.Message)
+}
@@ -0,0 +1,36 @@
+package binance
+
+import (
+	"github.com/stretchr/testify/assert"
+	"github.com/trustwallet/blockatlas/pkg/blockatlas"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestNormalizeBroadcastResult(t *testing.T) {
+	hash, err := NormalizeBroadcastResult([]byte(`[{"code":0,"hash":"E2E13B4F8CFBAF8DE7C7A0C2E07F3D8D3EFC05D2A5BF3E5D9B3F3F6C1BB4DBA7","log":"Msg 0: ","ok":true}]`))
+	assert.Nil(t, err)
+	assert.Equal(t, "E2E13B4F8CFBAF8DE7C7A0C2E07F3D8D3EFC05D2A5BF3E5D9B3F3F6C1BB4DBA7", hash)
+
+	_, err = NormalizeBroadcastResult([]byte(`{"code":65540,"failed_tx_index":0,"message":"Invalid sequence. Got 12, expected 13","success_tx_results":[]}`))
+	assert.Equal(t, blockatlas.ErrNonceTooLow, err.(*blockatlas.BroadcastError).Err)
+
+	_, err = NormalizeBroadcastResult([]byte(`{"code":65540,"message":"signature verification failed"}`))
+	assert.Equal(t, blockatlas.ErrInvalidSignature, err.(*blockatlas.BroadcastError).Err)
+}
+
+func TestPlatform_BroadcastTransaction(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = w.Write([]byte(`{"code":65540,"failed_tx_index":0,"message":"Invalid sequence. Got 12, expected 13","success_tx_results":[]}`))
+	}))
+	defer server.Close()
+
+	p := Platform{dexClient: DexClient{blockatlas.InitClient(server.URL)}}
+	p.dexClient.ErrorHandler = getHTTPError
+	_, err := p.BroadcastTransaction("deadbeef")
+	if assert.IsType(t, &blockatlas.BroadcastError{}, err) {
+		assert.Equal(t, blockatlas.ErrNonceTooLow, err.(*blockatlas.BroadcastError).Err)
+	}
+}
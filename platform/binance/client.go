@@ -1,6 +1,7 @@
 package binance
 
 import (
+	"context"
 	"encoding/json"
 	"github.com/trustwallet/blockatlas/pkg/blockatlas"
 	"github.com/trustwallet/blockatlas/pkg/errors"
@@ -16,14 +17,14 @@ type Client struct {
 	blockatlas.Request
 }
 
-func (c *Client) GetBlockList(count int) (*BlockList, error) {
+func (c *Client) GetBlockList(ctx context.Context, count int) (*BlockList, error) {
 	result := new(BlockList)
 	query := url.Values{"rows": {strconv.Itoa(count)}, "page": {"1"}}
-	err := c.Get(result, "blocks", query)
+	err := c.GetWithContext(ctx, result, "blocks", query)
 	return result, err
 }
 
-func (c *Client) GetBlockByNumber(num int64) (*TxPage, error) {
+func (c *Client) GetBlockByNumber(ctx context.Context, num int64) (*TxPage, error) {
 	stx := new(TxPage)
 	query := url.Values{
 		"blockHeight": {strconv.FormatInt(num, 10)},
@@ -32,19 +33,19 @@ func (c *Client) GetBlockByNumber(num int64) (*TxPage, error) {
 		"rows": {"100"},
 		"page": {"1"},
 	}
-	err := c.Get(stx, "txs", query)
+	err := c.GetWithContext(ctx, stx, "txs", query)
 	return stx, err
 }
 
-func (c *Client) GetTxsOfAddress(address string, token string) (*TxPage, error) {
+func (c *Client) GetTxsOfAddress(ctx context.Context, address string, token string) (*TxPage, error) {
 	stx := new(TxPage)
 	query := url.Values{"address": {address}, "rows": {"20"}, "page": {"1"}, "txAsset": {token}, "txType": {"TRANSFER"}}
-	err := c.Get(stx, "txs", query)
+	err := c.GetWithContext(ctx, stx, "txs", query)
 	return stx, err
 }
 
-func (c *Client) GetTx(hash string) (stx Tx, err error) {
-	err = c.Get(&stx, "tx", url.Values{"txHash": {hash}})
+func (c *Client) GetTx(ctx context.Context, hash string) (stx Tx, err error) {
+	err = c.GetWithContext(ctx, &stx, "tx", url.Values{"txHash": {hash}})
 	return
 }
 
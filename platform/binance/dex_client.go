@@ -1,9 +1,12 @@
 package binance
 
 import (
+	"context"
+	"encoding/json"
 	"fmt"
 	"github.com/trustwallet/blockatlas/pkg/blockatlas"
 	"net/url"
+	"strings"
 )
 
 // TODO Headers + rate limiting
@@ -12,15 +15,26 @@ type DexClient struct {
 	blockatlas.Request
 }
 
-func (c *DexClient) GetAccountMetadata(address string) (account *Account, err error) {
+func (c *DexClient) GetAccountMetadata(ctx context.Context, address string) (account *Account, err error) {
 	path := fmt.Sprintf("v1/account/%s", address)
-	err = c.Get(&account, path, nil)
+	err = c.GetWithContext(ctx, &account, path, nil)
 	return account, err
 }
 
-func (c *DexClient) GetTokens() (*TokenPage, error) {
+func (c *DexClient) GetTokens(ctx context.Context) (*TokenPage, error) {
 	stp := new(TokenPage)
 	query := url.Values{"limit": {"1000"}, "offset": {"0"}}
-	err := c.Get(stp, "v1/tokens", query)
+	err := c.GetWithContext(ctx, stp, "v1/tokens", query)
 	return stp, err
 }
+
+// BroadcastTx sends the hex encoded signed transaction, the rejected transactions are returned in Error
+func (c *DexClient) BroadcastTx(ctx context.Context, raw string) (result json.RawMessage, err error) {
+	req := c.Request
+	req.ErrorHandler = blockatlas.DefaultErrorHandler
+	req.DecodeErrors = true
+	req.Headers = map[string]string{"Content-Type": "text/plain"}
+	uri := fmt.Sprintf("%s?%s", req.GetBase("v1/broadcast"), url.Values{"sync": {"true"}}.Encode())
+	err = req.ExecuteWithContext(ctx, "POST", uri, strings.NewReader(raw), &result)
+	return result, err
+}
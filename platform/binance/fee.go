@@ -0,0 +1,18 @@
+package binance
+
+import (
+	"context"
+	"github.com/trustwallet/blockatlas/coin"
+	"github.com/trustwallet/blockatlas/pkg/blockatlas"
+)
+
+// transferFee is the fixed fee of a transfer in jager, 0.000375 BNB
+const transferFee = "37500"
+
+func (p *Platform) GetFee(req blockatlas.FeeRequest) (*blockatlas.Fee, error) {
+	return p.GetFeeWithContext(context.Background(), req)
+}
+
+func (p *Platform) GetFeeWithContext(ctx context.Context, req blockatlas.FeeRequest) (*blockatlas.Fee, error) {
+	return blockatlas.StaticFee(coin.BNB, blockatlas.FeeUnitTx, transferFee), nil
+}
@@ -270,3 +270,10 @@ func convertValue(value interface{}) (float64, bool) {
 	}
 	return result, true
 }
+
+type BroadcastResult struct {
+	Code int64  `json:"code"`
+	Hash string `json:"hash"`
+	Log  string `json:"log"`
+	Ok   bool   `json:"ok"`
+}
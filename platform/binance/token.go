@@ -1,17 +1,22 @@
 package binance
 
 import (
+	"context"
 	"github.com/trustwallet/blockatlas/coin"
 	"github.com/trustwallet/blockatlas/pkg/blockatlas"
 	"strings"
 )
 
 func (p *Platform) GetTokenListByAddress(address string) (blockatlas.TokenPage, error) {
-	account, err := p.dexClient.GetAccountMetadata(address)
+	return p.GetTokenListByAddressWithContext(context.Background(), address)
+}
+
+func (p *Platform) GetTokenListByAddressWithContext(ctx context.Context, address string) (blockatlas.TokenPage, error) {
+	account, err := p.dexClient.GetAccountMetadata(ctx, address)
 	if err != nil || len(account.Balances) == 0 {
 		return []blockatlas.Token{}, nil
 	}
-	tokens, err := p.dexClient.GetTokens()
+	tokens, err := p.dexClient.GetTokens(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -1,6 +1,7 @@
 package binance
 
 import (
+	"context"
 	"github.com/trustwallet/blockatlas/pkg/blockatlas"
 	"github.com/trustwallet/blockatlas/pkg/logger"
 	"github.com/trustwallet/blockatlas/pkg/numbers"
@@ -11,24 +12,49 @@ import (
 )
 
 func (p *Platform) GetTxsByAddress(address string) (blockatlas.TxPage, error) {
+	return p.GetTxsByAddressWithContext(context.Background(), address)
+}
+
+func (p *Platform) GetTxsByAddressWithContext(ctx context.Context, address string) (blockatlas.TxPage, error) {
 	// Endpoint supports queries without token query parameter
 	return p.GetTokenTxsByAddress(address, p.Coin().Symbol)
 }
 
 func (p *Platform) GetTokenTxsByAddress(address string, token string) (blockatlas.TxPage, error) {
-	srcTxs, err := p.client.GetTxsOfAddress(address, token)
+	return p.GetTokenTxsByAddressWithContext(context.Background(), address, token)
+}
+
+func (p *Platform) GetTokenTxsByAddressWithContext(ctx context.Context, address string, token string) (blockatlas.TxPage, error) {
+	srcTxs, err := p.client.GetTxsOfAddress(ctx, address, token)
 	if err != nil {
 		return nil, err
 	}
-	txs, err := p.getTxChildChan(srcTxs.Txs)
+	txs, err := p.getTxChildChan(ctx, srcTxs.Txs)
 	if err != nil {
 		return nil, err
 	}
 	return NormalizeTxs(txs, token, address), nil
 }
 
+// GetTxByHash returns the transaction, multi send transactions return the first transfer
+func (p *Platform) GetTxByHash(hash string) (*blockatlas.Tx, error) {
+	return p.GetTxByHashWithContext(context.Background(), hash)
+}
+
+func (p *Platform) GetTxByHashWithContext(ctx context.Context, hash string) (*blockatlas.Tx, error) {
+	srcTx, err := p.client.GetTx(ctx, hash)
+	if err != nil {
+		return nil, err
+	}
+	txs, ok := NormalizeTx(srcTx, "", "")
+	if !ok || len(txs) == 0 {
+		return nil, blockatlas.ErrNotFound
+	}
+	return &txs[0], nil
+}
+
 // getTxChildChan get all child assets from a tx
-func (p *Platform) getTxChildChan(srcTxs []Tx) ([]Tx, error) {
+func (p *Platform) getTxChildChan(ctx context.Context, srcTxs []Tx) ([]Tx, error) {
 	txs := make([]Tx, 0)
 	var wg sync.WaitGroup
 	out := make(chan Tx, len(srcTxs))
@@ -41,7 +67,7 @@ func (p *Platform) getTxChildChan(srcTxs []Tx) ([]Tx, error) {
 		wg.Add(1)
 		go func(srcTx Tx, out chan Tx, wg *sync.WaitGroup) {
 			defer wg.Done()
-			tx, err := p.client.GetTx(srcTx.Hash)
+			tx, err := p.client.GetTx(ctx, srcTx.Hash)
 			if err != nil {
 				// Return the same transaction if an error occurs
 				out <- srcTx
@@ -0,0 +1,22 @@
+package bitcoin
+
+import (
+	"context"
+	"github.com/trustwallet/blockatlas/pkg/blockatlas"
+)
+
+func (p *Platform) GetBalance(address string) (*blockatlas.Balance, error) {
+	return p.GetBalanceWithContext(context.Background(), address)
+}
+
+func (p *Platform) GetBalanceWithContext(ctx context.Context, address string) (*blockatlas.Balance, error) {
+	addr, err := p.client.GetAddress(ctx, address)
+	if err != nil {
+		return nil, err
+	}
+	return &blockatlas.Balance{
+		Coin:    p.CoinIndex,
+		Address: address,
+		Balance: addr.Balance,
+	}, nil
+}
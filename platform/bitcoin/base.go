@@ -1,19 +1,24 @@
 package bitcoin
 
 import (
+	"context"
 	"github.com/trustwallet/blockatlas/coin"
 	"github.com/trustwallet/blockatlas/pkg/blockatlas"
 )
 
 type Platform struct {
 	client    Client
+	rpcClient RpcClient
+	mempool   *mempoolCache
 	CoinIndex uint
 }
 
-func Init(coin uint, api string) *Platform {
+func Init(coin uint, api, rpc string) *Platform {
 	return &Platform{
 		CoinIndex: coin,
 		client:    Client{blockatlas.InitClient(api)},
+		rpcClient: RpcClient{blockatlas.InitClient(rpc)},
+		mempool:   newMempoolCache(),
 	}
 }
 
@@ -22,7 +27,7 @@ func (p *Platform) Coin() coin.Coin {
 }
 
 func (p *Platform) GetAddressesFromXpub(xpub string) ([]string, error) {
-	tokens, err := p.client.GetAddressesFromXpub(xpub)
+	tokens, err := p.client.GetAddressesFromXpub(context.Background(), xpub)
 	addresses := make([]string, 0)
 	for _, token := range tokens {
 		addresses = append(addresses, token.Name)
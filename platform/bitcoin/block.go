@@ -1,17 +1,26 @@
 package bitcoin
 
 import (
+	"context"
 	"github.com/trustwallet/blockatlas/pkg/blockatlas"
 	"github.com/trustwallet/blockatlas/pkg/logger"
 	"sync"
 )
 
 func (p *Platform) CurrentBlockNumber() (int64, error) {
-	status, err := p.client.GetBlockNumber()
+	return p.CurrentBlockNumberWithContext(context.Background())
+}
+
+func (p *Platform) CurrentBlockNumberWithContext(ctx context.Context) (int64, error) {
+	status, err := p.client.GetBlockNumber(ctx)
 	return status.Backend.Blocks, err
 }
 
 func (p *Platform) GetAllBlockPages(total, num int64) []Transaction {
+	return p.getAllBlockPages(context.Background(), total, num)
+}
+
+func (p *Platform) getAllBlockPages(ctx context.Context, total, num int64) []Transaction {
 	txs := make([]Transaction, 0)
 	if total <= 1 {
 		return txs
@@ -25,7 +34,7 @@ func (p *Platform) GetAllBlockPages(total, num int64) []Transaction {
 		start++
 		go func(page, num int64, out chan TransactionsList, wg *sync.WaitGroup) {
 			defer wg.Done()
-			block, err := p.client.GetTransactionsByBlock(num, page)
+			block, err := p.client.GetTransactionsByBlock(ctx, num, page)
 			if err != nil {
 				logger.Error("GetTransactionsByBlockChan", err, logger.Params{"number": num, "page": page})
 				return
@@ -42,20 +51,25 @@ func (p *Platform) GetAllBlockPages(total, num int64) []Transaction {
 }
 
 func (p *Platform) GetBlockByNumber(num int64) (*blockatlas.Block, error) {
+	return p.GetBlockByNumberWithContext(context.Background(), num)
+}
+
+func (p *Platform) GetBlockByNumberWithContext(ctx context.Context, num int64) (*blockatlas.Block, error) {
 	page := int64(1)
-	block, err := p.client.GetTransactionsByBlock(num, page)
+	block, err := p.client.GetTransactionsByBlock(ctx, num, page)
 	if err != nil {
 		return nil, err
 	}
-	txPages := p.GetAllBlockPages(block.TotalPages, num)
+	txPages := p.getAllBlockPages(ctx, block.TotalPages, num)
 	txs := append(txPages, block.TransactionList()...)
 	var normalized []blockatlas.Tx
 	for _, tx := range txs {
 		normalized = append(normalized, normalizeTransaction(tx, p.CoinIndex))
 	}
 	return &blockatlas.Block{
-		Number: num,
-		ID:     block.Hash,
-		Txs:    normalized,
+		Number:   num,
+		ID:       block.Hash,
+		ParentID: block.PreviousHash,
+		Txs:      normalized,
 	}, nil
 }
@@ -0,0 +1,38 @@
+package bitcoin
+
+import (
+	"context"
+	"github.com/trustwallet/blockatlas/pkg/blockatlas"
+	"github.com/trustwallet/blockatlas/pkg/errors"
+	"strconv"
+	"strings"
+)
+
+func (p *Platform) BroadcastTransaction(raw string) (string, error) {
+	return p.BroadcastTransactionWithContext(context.Background(), raw)
+}
+
+func (p *Platform) BroadcastTransactionWithContext(ctx context.Context, raw string) (string, error) {
+	res, err := p.client.SendTransaction(ctx, raw)
+	if err != nil {
+		return "", err
+	}
+	if res.Error != "" {
+		return "", blockatlas.NewBroadcastError(trimRpcCode(res.Error))
+	}
+	if res.Result == "" {
+		return "", errors.E("Bitcoin: empty broadcast result", errors.TypePlatformUnmarshal)
+	}
+	return res.Result, nil
+}
+
+// trimRpcCode removes the code of the node error forwarded by Blockbook, e.g. "-26: min relay fee not met"
+func trimRpcCode(message string) string {
+	parts := strings.SplitN(message, ": ", 2)
+	if len(parts) == 2 {
+		if _, err := strconv.Atoi(parts[0]); err == nil {
+			return parts[1]
+		}
+	}
+	return message
+}
@@ -0,0 +1,28 @@
+package bitcoin
+
+import (
+	"github.com/stretchr/testify/assert"
+	"github.com/trustwallet/blockatlas/pkg/blockatlas"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestPlatform_BroadcastTransaction(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/v2/sendtx/" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = w.Write([]byte(`{"error":"-26: min relay fee not met"}`))
+	}))
+	defer server.Close()
+
+	p := Platform{client: Client{blockatlas.InitClient(server.URL)}}
+	_, err := p.BroadcastTransaction("0100000001")
+	if assert.IsType(t, &blockatlas.BroadcastError{}, err) {
+		assert.Equal(t, "min relay fee not met", err.(*blockatlas.BroadcastError).Message)
+		assert.Equal(t, blockatlas.ErrInvalidTx, err.(*blockatlas.BroadcastError).Err)
+	}
+}
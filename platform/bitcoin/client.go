@@ -1,37 +1,54 @@
 package bitcoin
 
 import (
+	"context"
 	"fmt"
 	"github.com/trustwallet/blockatlas/pkg/blockatlas"
 	"net/url"
 	"strconv"
+	"strings"
 )
 
 type Client struct {
 	blockatlas.Request
 }
 
-func (c *Client) GetTransactions(address string) (transactions TransactionsList, err error) {
+func (c *Client) GetTransactions(ctx context.Context, address string) (transactions TransactionsList, err error) {
 	path := fmt.Sprintf("address/%s", address)
-	err = c.Get(&transactions, path, url.Values{
+	err = c.GetWithContext(ctx, &transactions, path, url.Values{
 		"details":  {"txs"},
 		"pageSize": {strconv.Itoa(blockatlas.TxPerPage)},
 	})
 	return transactions, err
 }
 
-func (c *Client) GetTransactionsByXpub(xpub string) (transactions TransactionsList, err error) {
+// GetTransactionsUpTo returns a page of the newest transactions of the address up to the block, all of them if the block is 0
+func (c *Client) GetTransactionsUpTo(ctx context.Context, address string, block uint64, page int) (transactions TransactionsList, err error) {
+	path := fmt.Sprintf("v2/address/%s", address)
+	args := url.Values{
+		"details":  {"txs"},
+		"page":     {strconv.Itoa(page)},
+		"pageSize": {strconv.Itoa(blockatlas.TxPerPage)},
+	}
+	if block > 0 {
+		args.Set("to", strconv.FormatUint(block, 10))
+	}
+	err = c.GetWithContext(ctx, &transactions, path, args)
+	return transactions, err
+}
+
+func (c *Client) GetTransactionsByXpub(ctx context.Context, xpub string) (transactions TransactionsList, err error) {
 	path := fmt.Sprintf("v2/xpub/%s", xpub)
 	args := url.Values{
 		"pageSize": {strconv.Itoa(blockatlas.TxPerPage)},
 		"details":  {"txs"},
 		"tokens":   {"derived"},
 	}
-	err = c.Get(&transactions, path, args)
+	err = c.GetWithContext(ctx, &transactions, path, args)
 	return transactions, err
 }
 
-func (c *Client) GetAddressesFromXpub(xpub string) (tokens []Token, err error) {
+func (c *Client) GetAddressesFromXpub(ctx context.Context, xpub string) (tokens []Token, err error) {
 	path := fmt.Sprintf("v2/xpub/%s", xpub)
 	args := url.Values{
 		"pageSize": {strconv.Itoa(blockatlas.TxPerPage)},
@@ -39,20 +56,50 @@ func (c *Client) GetAddressesFromXpub(xpub string) (tokens []Token, err error) {
 		"tokens":   {"derived"},
 	}
 	var transactions TransactionsList
-	err = c.Get(&transactions, path, args)
+	err = c.GetWithContext(ctx, &transactions, path, args)
 	return transactions.Tokens, err
 }
 
-func (c *Client) GetTransactionsByBlock(number int64, page int64) (block TransactionsList, err error) {
+func (c *Client) GetTransactionsByBlock(ctx context.Context, number int64, page int64) (block TransactionsList, err error) {
 	path := fmt.Sprintf("v2/block/%s", strconv.FormatInt(number, 10))
 	args := url.Values{
 		"page": {strconv.FormatInt(page, 10)},
 	}
-	err = c.Get(&block, path, args)
+	err = c.GetWithContext(ctx, &block, path, args)
 	return block, err
 }
 
-func (c *Client) GetBlockNumber() (status BlockchainStatus, err error) {
-	err = c.Get(&status, "v2", nil)
+func (c *Client) GetBlockNumber(ctx context.Context) (status BlockchainStatus, err error) {
+	err = c.GetWithContext(ctx, &status, "v2", nil)
 	return status, err
 }
+
+func (c *Client) GetAddress(ctx context.Context, address string) (addr Address, err error) {
+	path := fmt.Sprintf("v2/address/%s", address)
+	err = c.GetWithContext(ctx, &addr, path, url.Values{"details": {"basic"}})
+	return addr, err
+}
+
+// SendTransaction posts the hex encoded transaction, the rejected transactions are returned in Error
+func (c *Client) SendTransaction(ctx context.Context, raw string) (result SendTxResult, err error) {
+	req := c.Request
+	req.DecodeErrors = true
+	err = req.ExecuteWithContext(ctx, "POST", req.GetBase("v2/sendtx/"), strings.NewReader(raw), &result)
+	return result, err
+}
+
+func (c *Client) EstimateFee(ctx context.Context, blocks int) (result EstimateFeeResult, err error) {
+	path := fmt.Sprintf("v2/estimatefee/%d", blocks)
+	err = c.GetWithContext(ctx, &result, path, nil)
+	return result, err
+}
+
+func (c *Client) GetTransaction(ctx context.Context, id string) (tx Transaction, err error) {
+	path := fmt.Sprintf("v2/tx/%s", id)
+	err = c.GetWithContext(ctx, &tx, path, nil)
+	// Blockbook answers unknown transactions with an error object and a bad request status
+	if e := blockatlas.AsUpstreamError(err); e != nil && (e.Kind == blockatlas.UpstreamBadRequest || e.Kind == blockatlas.UpstreamNotFound) {
+		return tx, blockatlas.ErrNotFound
+	}
+	return tx, err
+}
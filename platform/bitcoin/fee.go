@@ -0,0 +1,67 @@
+package bitcoin
+
+import (
+	"context"
+	"github.com/trustwallet/blockatlas/pkg/blockatlas"
+	"github.com/trustwallet/blockatlas/pkg/errors"
+	"math/big"
+)
+
+// The confirmation targets in blocks of the fee tiers
+const (
+	fastBlocks   = 2
+	normalBlocks = 6
+	slowBlocks   = 24
+)
+
+// minFeePerByte is used when the node can't estimate the fee, usually on an empty mempool
+const minFeePerByte = 1
+
+func (p *Platform) GetFee(req blockatlas.FeeRequest) (*blockatlas.Fee, error) {
+	return p.GetFeeWithContext(context.Background(), req)
+}
+
+func (p *Platform) GetFeeWithContext(ctx context.Context, req blockatlas.FeeRequest) (*blockatlas.Fee, error) {
+	fee := blockatlas.Fee{Coin: p.CoinIndex, Unit: blockatlas.FeeUnitByte}
+	tiers := []struct {
+		blocks int
+		value  *string
+	}{
+		{fastBlocks, &fee.Fast},
+		{normalBlocks, &fee.Normal},
+		{slowBlocks, &fee.Slow},
+	}
+	for _, tier := range tiers {
+		res, err := p.client.EstimateFee(ctx, tier.blocks)
+		if err != nil {
+			return nil, err
+		}
+		if res.Error != "" {
+			return nil, errors.E(res.Error, errors.Params{"coin": p.CoinIndex, "blocks": tier.blocks})
+		}
+		*tier.value, err = NormalizeFee(res.Result, p.Coin().Decimals)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return &fee, nil
+}
+
+// NormalizeFee converts the Blockbook estimate in coins per kilobyte to satoshis per byte, rounded up
+func NormalizeFee(perKB string, decimals uint) (string, error) {
+	fee, ok := new(big.Rat).SetString(perKB)
+	if !ok {
+		return "", errors.E("invalid fee estimate", errors.Params{"fee": perKB})
+	}
+	satoshis := new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(decimals)), nil)
+	fee.Mul(fee, new(big.Rat).SetFrac(satoshis, big.NewInt(1000)))
+
+	perByte := new(big.Int).Quo(fee.Num(), fee.Denom())
+	if new(big.Rat).SetInt(perByte).Cmp(fee) < 0 {
+		perByte.Add(perByte, big.NewInt(1))
+	}
+	if perByte.Cmp(big.NewInt(minFeePerByte)) < 0 {
+		perByte.SetInt64(minFeePerByte)
+	}
+	return perByte.String(), nil
+}
@@ -0,0 +1,29 @@
+package bitcoin
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestNormalizeFee(t *testing.T) {
+	tests := []struct {
+		name  string
+		perKB string
+		want  string
+	}{
+		{"exact", "0.00020000", "20"},
+		{"rounded up", "0.00012345", "13"},
+		{"below minimum", "0.00000500", "1"},
+		{"no estimate", "-1", "1"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := NormalizeFee(tt.perKB, 8)
+			assert.Nil(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+
+	_, err := NormalizeFee("", 8)
+	assert.NotNil(t, err)
+}
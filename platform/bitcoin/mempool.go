@@ -0,0 +1,128 @@
+package bitcoin
+
+import (
+	"context"
+	"github.com/trustwallet/blockatlas/pkg/blockatlas"
+	"github.com/trustwallet/blockatlas/pkg/errors"
+	"github.com/trustwallet/blockatlas/pkg/logger"
+	"github.com/trustwallet/blockatlas/pkg/semaphore"
+	"sync"
+)
+
+const (
+	// maxMempoolFetch limits the Blockbook lookups of a single poll,
+	// the remaining transactions are fetched by the next polls
+	maxMempoolFetch = 200
+	// mempoolFetchConns is the number of concurrent Blockbook lookups
+	mempoolFetchConns = 8
+)
+
+// GetMempoolTxs lists the node mempool and fetches the details of the new transactions from Blockbook.
+// The transactions which were in the mempool before the first call are skipped.
+func (p *Platform) GetMempoolTxs() (blockatlas.TxPage, error) {
+	if p.rpcClient.BaseUrl == "" {
+		return nil, errors.E("rpc url is not configured", errors.Params{"coin": p.CoinIndex})
+	}
+	ids, err := p.rpcClient.GetRawMempool(context.Background())
+	if err != nil {
+		return nil, err
+	}
+	return p.mempool.update(ids, p.getMempoolTx), nil
+}
+
+func (p *Platform) getMempoolTx(id string) (blockatlas.Tx, error) {
+	srcTx, err := p.client.GetTransaction(context.Background(), id)
+	if err != nil {
+		return blockatlas.Tx{}, err
+	}
+	if srcTx.ID == "" {
+		return blockatlas.Tx{}, blockatlas.ErrNotFound
+	}
+	tx := normalizeTransaction(srcTx, p.CoinIndex)
+	tx.Status = blockatlas.StatusPending
+	return tx, nil
+}
+
+// mempoolCache keeps the transactions currently in the mempool,
+// so every transaction is fetched from Blockbook only once
+type mempoolCache struct {
+	lock  sync.Mutex
+	ready bool
+	seen  map[string]bool
+	txs   map[string]blockatlas.Tx
+}
+
+func newMempoolCache() *mempoolCache {
+	return &mempoolCache{
+		seen: make(map[string]bool),
+		txs:  make(map[string]blockatlas.Tx),
+	}
+}
+
+// update syncs the cache with the ids of the mempool and returns the known transactions
+func (m *mempoolCache) update(ids []string, fetch func(id string) (blockatlas.Tx, error)) blockatlas.TxPage {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	current := make(map[string]bool, len(ids))
+	for _, id := range ids {
+		current[id] = true
+	}
+	for id := range m.seen {
+		if !current[id] {
+			delete(m.seen, id)
+			delete(m.txs, id)
+		}
+	}
+
+	// The first snapshot is only a baseline, the pending notifications start with the next one
+	if !m.ready {
+		m.seen = current
+		m.ready = true
+		return blockatlas.TxPage{}
+	}
+
+	missing := make([]string, 0)
+	for _, id := range ids {
+		if m.seen[id] {
+			continue
+		}
+		missing = append(missing, id)
+		if len(missing) == maxMempoolFetch {
+			break
+		}
+	}
+
+	var wg sync.WaitGroup
+	var resultLock sync.Mutex
+	sem := semaphore.NewSemaphore(mempoolFetchConns)
+	for _, id := range missing {
+		wg.Add(1)
+		go func(id string) {
+			defer wg.Done()
+			sem.Acquire()
+			defer sem.Release()
+			tx, err := fetch(id)
+			resultLock.Lock()
+			defer resultLock.Unlock()
+			if err == blockatlas.ErrNotFound {
+				// Left the mempool in the meantime
+				m.seen[id] = true
+				return
+			}
+			if err != nil {
+				logger.Error(err, "Mempool transaction lookup failed", logger.Params{"txid": id})
+				return
+			}
+			m.seen[id] = true
+			m.txs[id] = tx
+		}(id)
+	}
+	wg.Wait()
+
+	txs := make(blockatlas.TxPage, 0, len(m.txs))
+	for _, tx := range m.txs {
+		txs = append(txs, tx)
+	}
+	return txs
+}
@@ -0,0 +1,38 @@
+package bitcoin
+
+import (
+	"github.com/stretchr/testify/assert"
+	"github.com/trustwallet/blockatlas/pkg/blockatlas"
+	"sync"
+	"testing"
+)
+
+func TestMempoolCache_update(t *testing.T) {
+	var lock sync.Mutex
+	fetched := make([]string, 0)
+	fetch := func(id string) (blockatlas.Tx, error) {
+		lock.Lock()
+		fetched = append(fetched, id)
+		lock.Unlock()
+		if id == "gone" {
+			return blockatlas.Tx{}, blockatlas.ErrNotFound
+		}
+		return blockatlas.Tx{ID: id, Status: blockatlas.StatusPending}, nil
+	}
+	m := newMempoolCache()
+
+	// The first snapshot is the baseline
+	txs := m.update([]string{"old"}, fetch)
+	assert.Len(t, txs, 0)
+	assert.Len(t, fetched, 0)
+
+	txs = m.update([]string{"old", "new", "gone"}, fetch)
+	assert.Equal(t, blockatlas.TxPage{{ID: "new", Status: blockatlas.StatusPending}}, txs)
+	assert.ElementsMatch(t, []string{"new", "gone"}, fetched)
+
+	// Known transactions are not fetched again, confirmed ones are evicted
+	txs = m.update([]string{"new"}, fetch)
+	assert.Len(t, txs, 1)
+	assert.Len(t, fetched, 2)
+	assert.False(t, m.seen["old"])
+}
@@ -13,6 +13,7 @@ type TransactionsList struct {
 	Tokens       []Token       `json:"tokens,omitempty"`
 	TxCount      int64         `json:"txCount,omitempty"`
 	Hash         string        `json:"hash,omitempty"`
+	PreviousHash string        `json:"previousBlockHash,omitempty"`
 }
 
 func (tl *TransactionsList) TransactionList() []Transaction {
@@ -99,3 +100,19 @@ func (transaction Transaction) GetBlockHeight() uint64 {
 	}
 	return 0
 }
+
+type Address struct {
+	Address            string `json:"address"`
+	Balance            string `json:"balance"`
+	UnconfirmedBalance string `json:"unconfirmedBalance"`
+}
+
+type SendTxResult struct {
+	Result string `json:"result"`
+	Error  string `json:"error"`
+}
+
+type EstimateFeeResult struct {
+	Result string `json:"result"`
+	Error  string `json:"error"`
+}
@@ -0,0 +1,16 @@
+package bitcoin
+
+import (
+	"context"
+	"github.com/trustwallet/blockatlas/pkg/blockatlas"
+)
+
+// RpcClient calls the node JSON-RPC, Blockbook doesn't list the mempool transactions
+type RpcClient struct {
+	blockatlas.Request
+}
+
+func (c *RpcClient) GetRawMempool(ctx context.Context) (ids []string, err error) {
+	err = c.RpcCallWithContext(ctx, &ids, "getrawmempool", []interface{}{})
+	return
+}
@@ -1,6 +1,7 @@
 package bitcoin
 
 import (
+	"context"
 	mapset "github.com/deckarep/golang-set"
 	"github.com/gin-gonic/gin"
 	"github.com/trustwallet/blockatlas/coin"
@@ -31,7 +32,7 @@ func (p *Platform) RegisterRoutes(router gin.IRouter) {
 
 func (p *Platform) handleAddressRoute(c *gin.Context) {
 	address := c.Param("address")
-	txs, ok := p.getTxsByAddress(address)
+	txs, ok := p.getTxsByAddress(c.Request.Context(), address)
 	txPage := blockatlas.TxPage(txs)
 	sort.Sort(txPage)
 	if ok != nil {
@@ -43,7 +44,7 @@ func (p *Platform) handleAddressRoute(c *gin.Context) {
 
 func (p *Platform) handleXpubRoute(c *gin.Context) {
 	xpub := c.Param("key")
-	txs, ok := p.getTxsByXPub(xpub)
+	txs, ok := p.getTxsByXPub(c.Request.Context(), xpub)
 	txPage := blockatlas.TxPage(txs)
 	sort.Sort(txPage)
 	if ok != nil {
@@ -53,8 +54,8 @@ func (p *Platform) handleXpubRoute(c *gin.Context) {
 	c.JSON(http.StatusOK, &txPage)
 }
 
-func (p *Platform) getTxsByXPub(xpub string) ([]blockatlas.Tx, error) {
-	sourceTxs, err := p.client.GetTransactionsByXpub(xpub)
+func (p *Platform) getTxsByXPub(ctx context.Context, xpub string) ([]blockatlas.Tx, error) {
+	sourceTxs, err := p.client.GetTransactionsByXpub(ctx, xpub)
 
 	if err != nil {
 		return []blockatlas.Tx{}, err
@@ -69,8 +70,8 @@ func (p *Platform) getTxsByXPub(xpub string) ([]blockatlas.Tx, error) {
 	return txs, nil
 }
 
-func (p *Platform) getTxsByAddress(address string) ([]blockatlas.Tx, error) {
-	sourceTxs, err := p.client.GetTransactions(address)
+func (p *Platform) getTxsByAddress(ctx context.Context, address string) ([]blockatlas.Tx, error) {
+	sourceTxs, err := p.client.GetTransactions(ctx, address)
 	if err != nil {
 		return []blockatlas.Tx{}, err
 	}
@@ -80,6 +81,42 @@ func (p *Platform) getTxsByAddress(address string) ([]blockatlas.Tx, error) {
 	return txs, nil
 }
 
+// GetTxsByAddressFromCursor returns the transactions older than the cursor, Blockbook pages from the block of the cursor
+func (p *Platform) GetTxsByAddressFromCursor(ctx context.Context, address string, cursor *blockatlas.TxCursor) (blockatlas.TxCursorPage, error) {
+	addressSet := mapset.NewSet()
+	addressSet.Add(address)
+	return blockatlas.FetchCursorPage(cursor, blockatlas.TxPerPage, func(to uint64, n int) (blockatlas.TxPage, bool, error) {
+		sourceTxs, err := p.client.GetTransactionsUpTo(ctx, address, to, n)
+		if err != nil {
+			return nil, false, err
+		}
+		return normalizeTxs(sourceTxs, p.CoinIndex, addressSet), sourceTxs.Page >= sourceTxs.TotalPages, nil
+	})
+}
+
+// GetTxByHash returns the transaction without a direction, the value is the amount of the first output
+func (p *Platform) GetTxByHash(hash string) (*blockatlas.Tx, error) {
+	return p.GetTxByHashWithContext(context.Background(), hash)
+}
+
+func (p *Platform) GetTxByHashWithContext(ctx context.Context, hash string) (*blockatlas.Tx, error) {
+	srcTx, err := p.client.GetTransaction(ctx, hash)
+	if err != nil {
+		return nil, err
+	}
+	// Blockbook answers unknown transactions with an error object
+	if srcTx.ID == "" {
+		return nil, blockatlas.ErrNotFound
+	}
+	tx := normalizeTransaction(srcTx, p.CoinIndex)
+	tx.Meta = blockatlas.Transfer{
+		Value:    InferValue(&tx, blockatlas.DirectionOutgoing, nil),
+		Symbol:   coin.Coins[p.CoinIndex].Symbol,
+		Decimals: coin.Coins[p.CoinIndex].Decimals,
+	}
+	return &tx, nil
+}
+
 func normalizeTxs(sourceTxs TransactionsList, coinIndex uint, addressSet mapset.Set) []blockatlas.Tx {
 	var txs []blockatlas.Tx
 	for _, transaction := range sourceTxs.TransactionList() {
@@ -118,7 +155,7 @@ func InferDirection(tx *blockatlas.Tx, addressSet mapset.Set) blockatlas.Directi
 	if intersect.Cardinality() == 0 {
 		return blockatlas.DirectionIncoming
 	}
-	if outputSet.IsProperSubset(addressSet) || outputSet.Equal(inputSet) {
+	if outputSet.IsSubset(addressSet) || outputSet.Equal(inputSet) {
 		return blockatlas.DirectionSelf
 	}
 	return blockatlas.DirectionOutgoing
@@ -131,6 +168,21 @@ func InferValue(tx *blockatlas.Tx, direction blockatlas.Direction, addressSet ma
 	}
 	if direction == blockatlas.DirectionOutgoing || direction == blockatlas.DirectionSelf {
 		value = tx.Outputs[0].Value
+		if direction == blockatlas.DirectionOutgoing && addressSet != nil {
+			// the change returned to the addresses of the set is not the sent amount
+			amount := blockatlas.Amount("0")
+			sent := false
+			for _, output := range tx.Outputs {
+				if addressSet.Contains(output.Address) {
+					continue
+				}
+				amount = blockatlas.Amount(numbers.AddAmount(string(amount), string(output.Value)))
+				sent = true
+			}
+			if sent {
+				value = amount
+			}
+		}
 	} else if direction == blockatlas.DirectionIncoming {
 		amount := value
 		for _, output := range tx.Outputs {
@@ -2,11 +2,14 @@ package bitcoin
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	mapset "github.com/deckarep/golang-set"
 	"github.com/stretchr/testify/assert"
 	"github.com/trustwallet/blockatlas/coin"
 	"github.com/trustwallet/blockatlas/pkg/blockatlas"
+	"net/http"
+	"net/http/httptest"
 	"testing"
 )
 
@@ -346,3 +349,27 @@ func TestTransactionStatus(t *testing.T) {
 		assert.Equal(t, test.Expected, test.Tx.getStatus())
 	}
 }
+
+func TestPlatform_GetTxsByAddressFromCursor(t *testing.T) {
+	var to string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		to = r.URL.Query().Get("to")
+		switch r.URL.Query().Get("page") {
+		case "1":
+			_, _ = w.Write([]byte(`{"page":1,"totalPages":2,"transactions":[{"txid":"c","blockHeight":100},{"txid":"b","blockHeight":95}]}`))
+		default:
+			_, _ = w.Write([]byte(`{"page":2,"totalPages":2,"transactions":[{"txid":"a","blockHeight":95},{"txid":"z","blockHeight":90}]}`))
+		}
+	}))
+	defer server.Close()
+
+	// Block 95 spans the pages of Blockbook
+	p := Platform{client: Client{blockatlas.InitClient(server.URL)}}
+	page, err := p.GetTxsByAddressFromCursor(context.Background(), "bc1q", nil)
+	assert.Nil(t, err)
+	assert.Equal(t, "", to)
+	assert.Len(t, page.Txs, 4)
+	assert.Equal(t, "a", page.Txs[1].ID)
+	assert.Equal(t, "b", page.Txs[2].ID)
+	assert.Nil(t, page.NextCursor)
+}
@@ -0,0 +1,34 @@
+package cosmos
+
+import (
+	"context"
+	"github.com/trustwallet/blockatlas/pkg/blockatlas"
+)
+
+func (p *Platform) GetBalance(address string) (*blockatlas.Balance, error) {
+	return p.GetBalanceWithContext(context.Background(), address)
+}
+
+func (p *Platform) GetBalanceWithContext(ctx context.Context, address string) (*blockatlas.Balance, error) {
+	account, err := p.client.GetAccount(ctx, address)
+	if err != nil {
+		return nil, err
+	}
+	return NormalizeBalance(account.Account.Value.Coins, p.Denom(), p.Coin().ID, address), nil
+}
+
+// NormalizeBalance converts the account coins, the coins other than the native denom are returned as tokens
+func NormalizeBalance(coins []Balance, denom DenomType, coinIndex uint, address string) *blockatlas.Balance {
+	balance := blockatlas.Balance{Coin: coinIndex, Address: address, Balance: "0"}
+	for _, c := range coins {
+		if c.Denom == denom {
+			balance.Balance = c.Amount
+			continue
+		}
+		balance.Tokens = append(balance.Tokens, blockatlas.TokenBalance{
+			TokenID: string(c.Denom),
+			Balance: c.Amount,
+		})
+	}
+	return &balance
+}
@@ -0,0 +1,22 @@
+package cosmos
+
+import (
+	"github.com/stretchr/testify/assert"
+	"github.com/trustwallet/blockatlas/coin"
+	"github.com/trustwallet/blockatlas/pkg/blockatlas"
+	"testing"
+)
+
+func TestNormalizeBalance(t *testing.T) {
+	coins := []Balance{
+		{Denom: "bnb", Amount: "100"},
+		{Denom: DenomKava, Amount: "2500000"},
+	}
+	balance := NormalizeBalance(coins, DenomKava, coin.KAVA, "kava1l8va7kh4pz9v4xzyxmrwr3lvq4y5h0xx2ngp7z")
+	assert.Equal(t, "2500000", balance.Balance)
+	assert.Equal(t, []blockatlas.TokenBalance{{TokenID: "bnb", Balance: "100"}}, balance.Tokens)
+
+	empty := NormalizeBalance(nil, DenomAtom, coin.ATOM, "cosmos1")
+	assert.Equal(t, "0", empty.Balance)
+	assert.Empty(t, empty.Tokens)
+}
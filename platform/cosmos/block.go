@@ -1,11 +1,16 @@
 package cosmos
 
 import (
+	"context"
 	"github.com/trustwallet/blockatlas/pkg/blockatlas"
 )
 
 func (p *Platform) GetBlockByNumber(num int64) (*blockatlas.Block, error) {
-	srcTxs, err := p.client.GetBlockByNumber(num)
+	return p.GetBlockByNumberWithContext(context.Background(), num)
+}
+
+func (p *Platform) GetBlockByNumberWithContext(ctx context.Context, num int64) (*blockatlas.Block, error) {
+	srcTxs, err := p.client.GetBlockByNumber(ctx, num)
 	if err != nil {
 		return nil, err
 	}
@@ -18,5 +23,9 @@ func (p *Platform) GetBlockByNumber(num int64) (*blockatlas.Block, error) {
 }
 
 func (p *Platform) CurrentBlockNumber() (int64, error) {
-	return p.client.CurrentBlockNumber()
+	return p.CurrentBlockNumberWithContext(context.Background())
+}
+
+func (p *Platform) CurrentBlockNumberWithContext(ctx context.Context) (int64, error) {
+	return p.client.CurrentBlockNumber(ctx)
 }
@@ -0,0 +1,72 @@
+package cosmos
+
+import (
+	"context"
+	"encoding/json"
+	"github.com/trustwallet/blockatlas/pkg/blockatlas"
+	"github.com/trustwallet/blockatlas/pkg/errors"
+)
+
+const broadcastModeSync = "sync"
+
+// Error codes of the sdk codespace
+const (
+	codeInvalidSequence   = 3
+	codeUnauthorized      = 4
+	codeInsufficientFunds = 5
+	codeInsufficientFee   = 14
+)
+
+// BroadcastTransaction accepts either the signed StdTx or the complete request body with the tx and the mode
+func (p *Platform) BroadcastTransaction(raw string) (string, error) {
+	return p.BroadcastTransactionWithContext(context.Background(), raw)
+}
+
+func (p *Platform) BroadcastTransactionWithContext(ctx context.Context, raw string) (string, error) {
+	req, err := NormalizeBroadcastRequest(raw)
+	if err != nil {
+		return "", err
+	}
+	res, err := p.client.BroadcastTx(ctx, req)
+	if err != nil {
+		return "", err
+	}
+	if res.Error != "" {
+		return "", blockatlas.NewBroadcastError(res.Error)
+	}
+	if res.Code != 0 {
+		return "", NormalizeBroadcastError(res)
+	}
+	if res.TxHash == "" {
+		return "", errors.E("Cosmos: empty broadcast result", errors.TypePlatformUnmarshal)
+	}
+	return res.TxHash, nil
+}
+
+func NormalizeBroadcastRequest(raw string) (BroadcastTxRequest, error) {
+	var req BroadcastTxRequest
+	if err := json.Unmarshal([]byte(raw), &req); err != nil {
+		return req, &blockatlas.BroadcastError{Err: blockatlas.ErrInvalidTx, Message: err.Error()}
+	}
+	if len(req.Tx) == 0 {
+		req = BroadcastTxRequest{Tx: json.RawMessage(raw)}
+	}
+	req.Mode = broadcastModeSync
+	return req, nil
+}
+
+func NormalizeBroadcastError(res BroadcastTxResult) *blockatlas.BroadcastError {
+	if res.Codespace != "" && res.Codespace != "sdk" {
+		return blockatlas.NewBroadcastError(res.RawLog)
+	}
+	switch res.Code {
+	case codeInvalidSequence:
+		return &blockatlas.BroadcastError{Err: blockatlas.ErrNonceTooLow, Message: res.RawLog}
+	case codeUnauthorized:
+		return &blockatlas.BroadcastError{Err: blockatlas.ErrInvalidSignature, Message: res.RawLog}
+	case codeInsufficientFunds, codeInsufficientFee:
+		return &blockatlas.BroadcastError{Err: blockatlas.ErrInsufficientFunds, Message: res.RawLog}
+	default:
+		return blockatlas.NewBroadcastError(res.RawLog)
+	}
+}
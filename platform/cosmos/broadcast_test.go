@@ -0,0 +1,54 @@
+package cosmos
+
+import (
+	"github.com/stretchr/testify/assert"
+	"github.com/trustwallet/blockatlas/pkg/blockatlas"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestNormalizeBroadcastRequest(t *testing.T) {
+	stdTx := `{"type":"cosmos-sdk/StdTx","value":{"msg":[],"signatures":[]}}`
+	req, err := NormalizeBroadcastRequest(stdTx)
+	assert.Nil(t, err)
+	assert.JSONEq(t, stdTx, string(req.Tx))
+	assert.Equal(t, broadcastModeSync, req.Mode)
+
+	req, err = NormalizeBroadcastRequest(`{"mode":"block","tx":` + stdTx + `}`)
+	assert.Nil(t, err)
+	assert.JSONEq(t, stdTx, string(req.Tx))
+	assert.Equal(t, broadcastModeSync, req.Mode)
+
+	_, err = NormalizeBroadcastRequest("0xdeadbeef")
+	assert.Equal(t, blockatlas.ErrInvalidTx, err.(*blockatlas.BroadcastError).Err)
+}
+
+func TestNormalizeBroadcastError(t *testing.T) {
+	tests := []struct {
+		res  BroadcastTxResult
+		want error
+	}{
+		{BroadcastTxResult{Code: 4, Codespace: "sdk", RawLog: "signature verification failed"}, blockatlas.ErrInvalidSignature},
+		{BroadcastTxResult{Code: 3, RawLog: "invalid sequence"}, blockatlas.ErrNonceTooLow},
+		{BroadcastTxResult{Code: 5, Codespace: "sdk", RawLog: "insufficient account funds"}, blockatlas.ErrInsufficientFunds},
+		{BroadcastTxResult{Code: 10, Codespace: "staking", RawLog: "validator does not exist"}, blockatlas.ErrInvalidTx},
+	}
+	for _, tt := range tests {
+		assert.Equal(t, tt.want, NormalizeBroadcastError(tt.res).Err, tt.res.RawLog)
+	}
+}
+
+func TestPlatform_BroadcastTransaction(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = w.Write([]byte(`{"error":"insufficient account funds; 10uatom < 100uatom"}`))
+	}))
+	defer server.Close()
+
+	p := Platform{client: Client{blockatlas.InitClient(server.URL)}}
+	_, err := p.BroadcastTransaction(`{"type":"cosmos-sdk/StdTx","value":{"msg":[],"signatures":[]}}`)
+	if assert.IsType(t, &blockatlas.BroadcastError{}, err) {
+		assert.Equal(t, blockatlas.ErrInsufficientFunds, err.(*blockatlas.BroadcastError).Err)
+	}
+}
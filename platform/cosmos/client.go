@@ -1,6 +1,7 @@
 package cosmos
 
 import (
+	"context"
 	"fmt"
 	"github.com/trustwallet/blockatlas/pkg/blockatlas"
 	"github.com/trustwallet/blockatlas/pkg/errors"
@@ -14,36 +15,44 @@ type Client struct {
 	blockatlas.Request
 }
 
-// GetAddrTxs - get all ATOM transactions for a given address
-func (c *Client) GetAddrTxs(address, tag string, page int) (txs TxPage, err error) {
+// GetAddrTxs - get all ATOM transactions for a given address up to the max height, all of them if it's 0
+func (c *Client) GetAddrTxs(ctx context.Context, address, tag string, maxHeight uint64, page int) (txs TxPage, err error) {
 	query := url.Values{
 		tag:     {address},
 		"page":  {strconv.Itoa(page)},
 		"limit": {"25"},
 	}
-	err = c.Get(&txs, "txs", query)
+	if maxHeight > 0 {
+		query.Set("tx.maxheight", strconv.FormatUint(maxHeight, 10))
+	}
+	err = c.GetWithContext(ctx, &txs, "txs", query)
 	if err != nil {
 		return TxPage{}, err
 	}
 	return
 }
 
-func (c *Client) GetValidators() (validators Validators, err error) {
+func (c *Client) GetTx(ctx context.Context, hash string) (tx Tx, err error) {
+	err = c.GetWithContext(ctx, &tx, fmt.Sprintf("txs/%s", hash), nil)
+	return
+}
+
+func (c *Client) GetValidators(ctx context.Context) (validators Validators, err error) {
 	query := url.Values{
 		"status": {"bonded"},
 	}
-	err = c.Get(&validators, "staking/validators", query)
+	err = c.GetWithContext(ctx, &validators, "staking/validators", query)
 	return
 }
 
-func (c *Client) GetBlockByNumber(num int64) (txs TxPage, err error) {
-	err = c.Get(&txs, "txs", url.Values{"tx.height": {strconv.FormatInt(num, 10)}})
+func (c *Client) GetBlockByNumber(ctx context.Context, num int64) (txs TxPage, err error) {
+	err = c.GetWithContext(ctx, &txs, "txs", url.Values{"tx.height": {strconv.FormatInt(num, 10)}})
 	return
 }
 
-func (c *Client) CurrentBlockNumber() (num int64, err error) {
+func (c *Client) CurrentBlockNumber(ctx context.Context) (num int64, err error) {
 	var block Block
-	err = c.Get(&block, "blocks/latest", nil)
+	err = c.GetWithContext(ctx, &block, "blocks/latest", nil)
 
 	if err != nil {
 		return num, err
@@ -57,35 +66,43 @@ func (c *Client) CurrentBlockNumber() (num int64, err error) {
 	return
 }
 
-func (c *Client) GetPool() (result StakingPool, err error) {
-	return result, c.Get(&result, "staking/pool", nil)
+func (c *Client) GetPool(ctx context.Context) (result StakingPool, err error) {
+	return result, c.GetWithContext(ctx, &result, "staking/pool", nil)
 }
 
-func (c *Client) GetInflation() (inflation Inflation, err error) {
-	err = c.Get(&inflation, "minting/inflation", nil)
+func (c *Client) GetInflation(ctx context.Context) (inflation Inflation, err error) {
+	err = c.GetWithContext(ctx, &inflation, "minting/inflation", nil)
 	return
 }
 
-func (c *Client) GetDelegations(address string) (delegations Delegations, err error) {
+func (c *Client) GetDelegations(ctx context.Context, address string) (delegations Delegations, err error) {
 	path := fmt.Sprintf("staking/delegators/%s/delegations", address)
-	err = c.Get(&delegations, path, nil)
+	err = c.GetWithContext(ctx, &delegations, path, nil)
 	if err != nil {
 		logger.Error(err, "Cosmos: Failed to get delegations for address")
 	}
 	return
 }
 
-func (c *Client) GetUnbondingDelegations(address string) (delegations UnbondingDelegations, err error) {
+func (c *Client) GetUnbondingDelegations(ctx context.Context, address string) (delegations UnbondingDelegations, err error) {
 	path := fmt.Sprintf("staking/delegators/%s/unbonding_delegations", address)
-	err = c.Get(&delegations, path, nil)
+	err = c.GetWithContext(ctx, &delegations, path, nil)
 	if err != nil {
 		logger.Error(err, "Cosmos: Failed to get unbonding delegations for address")
 	}
 	return
 }
 
-func (c *Client) GetAccount(address string) (result AuthAccount, err error) {
+func (c *Client) GetAccount(ctx context.Context, address string) (result AuthAccount, err error) {
 	path := fmt.Sprintf("auth/accounts/%s", address)
-	err = c.Get(&result, path, nil)
+	err = c.GetWithContext(ctx, &result, path, nil)
+	return
+}
+
+// BroadcastTx - broadcast a signed transaction and wait for CheckTx
+func (c *Client) BroadcastTx(ctx context.Context, tx BroadcastTxRequest) (result BroadcastTxResult, err error) {
+	req := c.Request
+	req.DecodeErrors = true
+	err = req.PostWithContext(ctx, &result, "txs", tx)
 	return
 }
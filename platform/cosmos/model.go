@@ -264,3 +264,17 @@ type Balance struct {
 	Denom  DenomType `json:"denom"`
 	Amount string    `json:"amount"`
 }
+
+type BroadcastTxRequest struct {
+	Tx   json.RawMessage `json:"tx"`
+	Mode string          `json:"mode"`
+}
+
+type BroadcastTxResult struct {
+	Height    string `json:"height"`
+	TxHash    string `json:"txhash"`
+	Code      int    `json:"code,omitempty"`
+	Codespace string `json:"codespace,omitempty"`
+	RawLog    string `json:"raw_log,omitempty"`
+	Error     string `json:"error,omitempty"`
+}
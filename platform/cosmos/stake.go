@@ -1,6 +1,7 @@
 package cosmos
 
 import (
+	"context"
 	"github.com/trustwallet/blockatlas/coin"
 	"github.com/trustwallet/blockatlas/pkg/blockatlas"
 	"github.com/trustwallet/blockatlas/pkg/errors"
@@ -16,17 +17,21 @@ const (
 )
 
 func (p *Platform) GetValidators() (blockatlas.ValidatorPage, error) {
+	return p.GetValidatorsWithContext(context.Background())
+}
+
+func (p *Platform) GetValidatorsWithContext(ctx context.Context) (blockatlas.ValidatorPage, error) {
 	results := make(blockatlas.ValidatorPage, 0)
-	validators, err := p.client.GetValidators()
+	validators, err := p.client.GetValidators(ctx)
 	if err != nil {
 		return nil, err
 	}
-	pool, err := p.client.GetPool()
+	pool, err := p.client.GetPool(ctx)
 	if err != nil {
 		return nil, err
 	}
 
-	inflation, err := p.client.GetInflation()
+	inflation, err := p.client.GetInflation(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -72,19 +77,23 @@ func (p *Platform) GetMaxAPR() float64 {
 }
 
 func (p *Platform) GetDelegations(address string) (blockatlas.DelegationsPage, error) {
+	return p.GetDelegationsWithContext(context.Background(), address)
+}
+
+func (p *Platform) GetDelegationsWithContext(ctx context.Context, address string) (blockatlas.DelegationsPage, error) {
 	results := make(blockatlas.DelegationsPage, 0)
-	delegations, err := p.client.GetDelegations(address)
+	delegations, err := p.client.GetDelegations(ctx, address)
 	if err != nil {
 		return nil, err
 	}
-	unbondingDelegations, err := p.client.GetUnbondingDelegations(address)
+	unbondingDelegations, err := p.client.GetUnbondingDelegations(ctx, address)
 	if err != nil {
 		return nil, err
 	}
 	if delegations.List == nil && unbondingDelegations.List == nil {
 		return results, nil
 	}
-	validators, err := services.GetValidatorsMap(p)
+	validators, err := services.GetValidatorsMap(ctx, p)
 	if err != nil {
 		return nil, err
 	}
@@ -95,7 +104,11 @@ func (p *Platform) GetDelegations(address string) (blockatlas.DelegationsPage, e
 }
 
 func (p *Platform) UndelegatedBalance(address string) (string, error) {
-	account, err := p.client.GetAccount(address)
+	return p.UndelegatedBalanceWithContext(context.Background(), address)
+}
+
+func (p *Platform) UndelegatedBalanceWithContext(ctx context.Context, address string) (string, error) {
+	account, err := p.client.GetAccount(ctx, address)
 	if err != nil {
 		return "0", err
 	}
@@ -1,7 +1,9 @@
 package cosmos
 
 import (
+	"context"
 	"github.com/trustwallet/blockatlas/pkg/blockatlas"
+	"github.com/trustwallet/blockatlas/pkg/errors"
 	"github.com/trustwallet/blockatlas/pkg/logger"
 	"github.com/trustwallet/blockatlas/pkg/numbers"
 	"strconv"
@@ -10,6 +12,10 @@ import (
 )
 
 func (p *Platform) GetTxsByAddress(address string) (blockatlas.TxPage, error) {
+	return p.GetTxsByAddressWithContext(context.Background(), address)
+}
+
+func (p *Platform) GetTxsByAddressWithContext(ctx context.Context, address string) (blockatlas.TxPage, error) {
 	tagsList := []string{"transfer.recipient", "message.sender"}
 	var wg sync.WaitGroup
 	out := make(chan []Tx, len(tagsList))
@@ -18,7 +24,7 @@ func (p *Platform) GetTxsByAddress(address string) (blockatlas.TxPage, error) {
 		go func(tag, addr string, wg *sync.WaitGroup) {
 			defer wg.Done()
 			page := 1
-			txs, err := p.client.GetAddrTxs(addr, tag, page)
+			txs, err := p.client.GetAddrTxs(ctx, addr, tag, 0, page)
 			if err != nil {
 				logger.Error("GetAddrTxs", err, logger.Params{"address": tag, "tag": tag})
 				return
@@ -36,7 +42,7 @@ func (p *Platform) GetTxsByAddress(address string) (blockatlas.TxPage, error) {
 			}
 			// gaia does support sort option, paginate to get latest transactions by passing total pages page
 			// https://github.com/cosmos/gaia/blob/f61b391aee5d04364d2b5539692bbb187ad9b946/docs/resources/gaiacli.md#query-transactions
-			txs2, err := p.client.GetAddrTxs(addr, tag, totalPages)
+			txs2, err := p.client.GetAddrTxs(ctx, addr, tag, 0, totalPages)
 			if err != nil {
 				logger.Error("GetAddrTxs", err, logger.Params{"address": tag, "tag": tag})
 				return
@@ -53,6 +59,46 @@ func (p *Platform) GetTxsByAddress(address string) (blockatlas.TxPage, error) {
 	return p.NormalizeTxs(srcTxs), nil
 }
 
+// GetTxsByAddressFromCursor pages from the block of the cursor, LCD sorts the transactions
+// by ascending height so the newest ones are on the last page of every tag
+func (p *Platform) GetTxsByAddressFromCursor(ctx context.Context, address string, cursor *blockatlas.TxCursor) (blockatlas.TxCursorPage, error) {
+	return blockatlas.FetchCursorPage(cursor, blockatlas.TxPerPage,
+		p.tagPager(ctx, address, "transfer.recipient"),
+		p.tagPager(ctx, address, "message.sender"),
+	)
+}
+
+// tagPager pages the transactions of the tag from the last page of LCD
+func (p *Platform) tagPager(ctx context.Context, address, tag string) blockatlas.UpstreamPager {
+	var (
+		first      TxPage
+		totalPages int
+	)
+	return func(to uint64, n int) (blockatlas.TxPage, bool, error) {
+		if n == 1 {
+			txs, err := p.client.GetAddrTxs(ctx, address, tag, to, 1)
+			if err != nil {
+				return nil, false, err
+			}
+			totalPages, err = strconv.Atoi(txs.PageTotal)
+			if err != nil {
+				return nil, false, errors.E(err, "Cosmos: invalid total pages", errors.TypePlatformUnmarshal,
+					errors.Params{"page_total": txs.PageTotal})
+			}
+			first = txs
+		}
+		page := totalPages - n + 1
+		if page <= 1 {
+			return p.NormalizeTxs(first.Txs), true, nil
+		}
+		txs, err := p.client.GetAddrTxs(ctx, address, tag, to, page)
+		if err != nil {
+			return nil, false, err
+		}
+		return p.NormalizeTxs(txs.Txs), false, nil
+	}
+}
+
 // NormalizeTxs converts multiple Cosmos transactions
 func (p *Platform) NormalizeTxs(srcTxs []Tx) blockatlas.TxPage {
 	txMap := make(map[string]bool)
@@ -71,6 +117,26 @@ func (p *Platform) NormalizeTxs(srcTxs []Tx) blockatlas.TxPage {
 	return txs
 }
 
+func (p *Platform) GetTxByHash(hash string) (*blockatlas.Tx, error) {
+	return p.GetTxByHashWithContext(context.Background(), hash)
+}
+
+func (p *Platform) GetTxByHashWithContext(ctx context.Context, hash string) (*blockatlas.Tx, error) {
+	srcTx, err := p.client.GetTx(ctx, hash)
+	if err != nil {
+		return nil, err
+	}
+	// Unknown transactions are returned as an error object without the hash
+	if srcTx.ID == "" {
+		return nil, blockatlas.ErrNotFound
+	}
+	tx, ok := p.Normalize(&srcTx)
+	if !ok {
+		return nil, blockatlas.ErrNotFound
+	}
+	return &tx, nil
+}
+
 // Normalize converts an Cosmos transaction into the generic model
 func (p *Platform) Normalize(srcTx *Tx) (tx blockatlas.Tx, ok bool) {
 	date, err := time.Parse("2006-01-02T15:04:05Z", srcTx.Date)
@@ -9,14 +9,16 @@ type Platform struct {
 	CoinIndex         uint
 	RpcURL            string
 	client            Client
+	rpcClient         RpcClient
 	collectionsClient CollectionsClient
 }
 
 func Init(coin uint, api, rpc string) *Platform {
 	return &Platform{
 		CoinIndex: coin,
-		RpcURL:    rpc,
+		RpcURL:    blockatlas.PrimaryEndpoint(rpc),
 		client:    Client{blockatlas.InitClient(api)},
+		rpcClient: RpcClient{blockatlas.InitClient(rpc)},
 	}
 }
 
@@ -1,26 +1,45 @@
 package ethereum
 
 import (
+	"context"
 	"github.com/trustwallet/blockatlas/pkg/blockatlas"
-	"strconv"
+	"github.com/trustwallet/blockatlas/pkg/errors"
 )
 
 func (p *Platform) CurrentBlockNumber() (int64, error) {
-	return p.client.CurrentBlockNumber()
+	return p.CurrentBlockNumberWithContext(context.Background())
+}
+
+func (p *Platform) CurrentBlockNumberWithContext(ctx context.Context) (int64, error) {
+	return p.client.CurrentBlockNumber(ctx)
 }
 
 func (p *Platform) GetBlockByNumber(num int64) (*blockatlas.Block, error) {
-	if srcPage, err := p.client.GetBlockByNumber(num); err == nil {
-		var txs []blockatlas.Tx
-		for _, srcTx := range srcPage {
-			txs = AppendTxs(txs, &srcTx, p.CoinIndex)
-		}
-		return &blockatlas.Block{
-			Number: num,
-			ID:     strconv.FormatInt(num, 10),
-			Txs:    txs,
-		}, nil
-	} else {
+	return p.GetBlockByNumberWithContext(context.Background(), num)
+}
+
+// GetBlockByNumberWithContext returns the transactions of the API in the block of the node,
+// the hashes of the node let the observer detect the chain reorganizations
+func (p *Platform) GetBlockByNumberWithContext(ctx context.Context, num int64) (*blockatlas.Block, error) {
+	header, err := p.rpcClient.GetBlockHeader(ctx, num)
+	if err != nil {
 		return nil, err
 	}
+	if header.Hash == "" {
+		return nil, errors.E("block not found", errors.Params{"block": num})
+	}
+	srcPage, err := p.client.GetBlockByNumber(ctx, num)
+	if err != nil {
+		return nil, err
+	}
+	var txs []blockatlas.Tx
+	for _, srcTx := range srcPage {
+		txs = AppendTxs(txs, &srcTx, p.CoinIndex)
+	}
+	return &blockatlas.Block{
+		Number:   num,
+		ID:       header.Hash,
+		ParentID: header.ParentHash,
+		Txs:      txs,
+	}, nil
 }
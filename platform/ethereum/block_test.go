@@ -0,0 +1,73 @@
+package ethereum
+
+import (
+	"context"
+	"encoding/json"
+	"github.com/stretchr/testify/assert"
+	"github.com/trustwallet/blockatlas/coin"
+	"github.com/trustwallet/blockatlas/pkg/blockatlas"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+// chainServer serves the node and the API of the chain, the blocks map the numbers to their hashes
+func chainServer(blocks map[string]RpcBlockHeader) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodGet {
+			_, _ = w.Write([]byte(`[]`))
+			return
+		}
+		var req blockatlas.RpcRequest
+		_ = json.NewDecoder(r.Body).Decode(&req)
+		params := req.Params.([]interface{})
+		header, ok := blocks[params[0].(string)]
+		if !ok {
+			_, _ = w.Write([]byte(`{"jsonrpc":"2.0","id":"eth_getBlockByNumber","result":null}`))
+			return
+		}
+		_ = json.NewEncoder(w).Encode(blockatlas.RpcResponse{JsonRpc: "2.0", Id: req.Id, Result: header})
+	}))
+}
+
+func TestPlatform_GetBlockByNumberWithContext(t *testing.T) {
+	server := chainServer(map[string]RpcBlockHeader{
+		"0xa": {Hash: "0x10a", ParentHash: "0x9"},
+		"0xb": {Hash: "0x11a", ParentHash: "0x10a"},
+	})
+	defer server.Close()
+	p := Init(coin.ETC, server.URL, server.URL)
+
+	block, err := p.GetBlockByNumberWithContext(context.Background(), 10)
+	assert.Nil(t, err)
+	assert.Equal(t, "0x10a", block.ID)
+	assert.Equal(t, "0x9", block.ParentID)
+
+	next, err := p.GetBlockByNumberWithContext(context.Background(), 11)
+	assert.Nil(t, err)
+	assert.Equal(t, block.ID, next.ParentID)
+
+	_, err = p.GetBlockByNumberWithContext(context.Background(), 12)
+	assert.NotNil(t, err)
+}
+
+func TestPlatform_GetBlockByNumberWithContext_fork(t *testing.T) {
+	server := chainServer(map[string]RpcBlockHeader{
+		"0xa": {Hash: "0x10a", ParentHash: "0x9"},
+	})
+	p := Init(coin.ETC, server.URL, server.URL)
+	block, err := p.GetBlockByNumberWithContext(context.Background(), 10)
+	assert.Nil(t, err)
+	server.Close()
+
+	// The node switched to a chain replacing block 10
+	server = chainServer(map[string]RpcBlockHeader{
+		"0xa": {Hash: "0x10b", ParentHash: "0x9"},
+		"0xb": {Hash: "0x11b", ParentHash: "0x10b"},
+	})
+	defer server.Close()
+	p = Init(coin.ETC, server.URL, server.URL)
+	next, err := p.GetBlockByNumberWithContext(context.Background(), 11)
+	assert.Nil(t, err)
+	assert.NotEqual(t, block.ID, next.ParentID)
+}
@@ -1,47 +1,67 @@
 package ethereum
 
 import (
+	"context"
 	"fmt"
 	"github.com/trustwallet/blockatlas/pkg/blockatlas"
 	"net/url"
+	"strconv"
 )
 
 type Client struct {
 	blockatlas.Request
 }
 
-func (c *Client) GetTxs(address string) (*Page, error) {
-	return c.getTxs(url.Values{"address": {address}})
+func (c *Client) GetTxs(ctx context.Context, address string) (*Page, error) {
+	return c.getTxs(ctx, url.Values{"address": {address}})
 }
 
-func (c *Client) GetTxsWithContract(address, contract string) (*Page, error) {
-	return c.getTxs(url.Values{"address": {address}, "contract": {contract}})
+func (c *Client) GetTxsWithContract(ctx context.Context, address, contract string) (*Page, error) {
+	return c.getTxs(ctx, url.Values{"address": {address}, "contract": {contract}})
 }
 
-func (c *Client) getTxs(query url.Values) (page *Page, err error) {
-	err = c.Get(&page, "transactions", query)
+// GetTxsUpTo returns a page of the newest transactions of the address up to the block, all of them if the block is 0.
+// The transactions are filtered by the contract unless it's empty
+func (c *Client) GetTxsUpTo(ctx context.Context, address, contract string, block uint64, page, limit int) (*Page, error) {
+	query := url.Values{"address": {address}, "page": {strconv.Itoa(page)}, "limit": {strconv.Itoa(limit)}}
+	if contract != "" {
+		query.Set("contract", contract)
+	}
+	if block > 0 {
+		query.Set("endBlock", strconv.FormatUint(block, 10))
+	}
+	return c.getTxs(ctx, query)
+}
+
+func (c *Client) getTxs(ctx context.Context, query url.Values) (page *Page, err error) {
+	err = c.GetWithContext(ctx, &page, "transactions", query)
+	return
+}
+
+func (c *Client) GetTx(ctx context.Context, hash string) (tx *Doc, err error) {
+	err = c.GetWithContext(ctx, &tx, fmt.Sprintf("transactions/%s", hash), nil)
 	return
 }
 
-func (c *Client) GetBlockByNumber(num int64) (page []Doc, err error) {
+func (c *Client) GetBlockByNumber(ctx context.Context, num int64) (page []Doc, err error) {
 	path := fmt.Sprintf("transactions/block/%d", num)
-	err = c.Get(&page, path, nil)
+	err = c.GetWithContext(ctx, &page, path, nil)
 	return
 }
 
-func (c *Client) CurrentBlockNumber() (int64, error) {
+func (c *Client) CurrentBlockNumber(ctx context.Context) (int64, error) {
 	var nodeInfo NodeInfo
-	err := c.Get(&nodeInfo, "node_info", nil)
+	err := c.GetWithContext(ctx, &nodeInfo, "node_info", nil)
 	if err != nil {
 		return 0, err
 	}
 	return nodeInfo.LatestBlock, nil
 }
 
-func (c *Client) GetTokens(address string) (tp *TokenPage, err error) {
+func (c *Client) GetTokens(ctx context.Context, address string) (tp *TokenPage, err error) {
 	query := url.Values{
 		"address": {address},
 	}
-	err = c.Get(&tp, "tokens", query)
+	err = c.GetWithContext(ctx, &tp, "tokens", query)
 	return
 }
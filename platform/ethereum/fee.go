@@ -0,0 +1,49 @@
+package ethereum
+
+import (
+	"context"
+	"github.com/trustwallet/blockatlas/pkg/blockatlas"
+	"github.com/trustwallet/blockatlas/pkg/errors"
+	"math/big"
+)
+
+func (p *Platform) GetFee(req blockatlas.FeeRequest) (*blockatlas.Fee, error) {
+	return p.GetFeeWithContext(context.Background(), req)
+}
+
+func (p *Platform) GetFeeWithContext(ctx context.Context, req blockatlas.FeeRequest) (*blockatlas.Fee, error) {
+	if p.RpcURL == "" {
+		return nil, errors.E("rpc url is not configured", errors.Params{"coin": p.CoinIndex})
+	}
+	gasPrice, err := p.rpcClient.GasPrice(ctx)
+	if err != nil {
+		return nil, err
+	}
+	fee := blockatlas.NewGasPriceFee(p.CoinIndex, gasPrice)
+	if req.To == "" {
+		return fee, nil
+	}
+
+	call, err := NormalizeCallRequest(req)
+	if err != nil {
+		return nil, err
+	}
+	gasLimit, err := p.rpcClient.EstimateGas(ctx, call)
+	if err != nil {
+		return nil, err
+	}
+	fee.GasLimit = gasLimit.String()
+	return fee, nil
+}
+
+func NormalizeCallRequest(req blockatlas.FeeRequest) (CallRequest, error) {
+	call := CallRequest{From: req.From, To: req.To, Data: req.Data}
+	if req.Value != "" {
+		value, ok := new(big.Int).SetString(req.Value, 10)
+		if !ok {
+			return call, errors.E("invalid value", errors.Params{"value": req.Value})
+		}
+		call.Value = "0x" + value.Text(16)
+	}
+	return call, nil
+}
@@ -0,0 +1,24 @@
+package ethereum
+
+import (
+	"github.com/stretchr/testify/assert"
+	"github.com/trustwallet/blockatlas/pkg/blockatlas"
+	"testing"
+)
+
+func TestNormalizeCallRequest(t *testing.T) {
+	call, err := NormalizeCallRequest(blockatlas.FeeRequest{
+		To:    "0x7d8bf18c7ce84b3e175b339c4ca93aed1dd166f1",
+		Value: "1000000000000000000",
+		Data:  "0xa9059cbb",
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, CallRequest{
+		To:    "0x7d8bf18c7ce84b3e175b339c4ca93aed1dd166f1",
+		Value: "0xde0b6b3a7640000",
+		Data:  "0xa9059cbb",
+	}, call)
+
+	_, err = NormalizeCallRequest(blockatlas.FeeRequest{To: "0x7d8bf18c7ce84b3e175b339c4ca93aed1dd166f1", Value: "1.5"})
+	assert.NotNil(t, err)
+}
@@ -0,0 +1,81 @@
+package ethereum
+
+import (
+	"context"
+	"github.com/trustwallet/blockatlas/coin"
+	"github.com/trustwallet/blockatlas/pkg/blockatlas"
+	"github.com/trustwallet/blockatlas/pkg/errors"
+	"github.com/trustwallet/blockatlas/pkg/numbers"
+	"strconv"
+)
+
+func (p *Platform) GetMempoolTxs() (blockatlas.TxPage, error) {
+	return p.GetMempoolTxsWithContext(context.Background())
+}
+
+func (p *Platform) GetMempoolTxsWithContext(ctx context.Context) (blockatlas.TxPage, error) {
+	if p.RpcURL == "" {
+		return nil, errors.E("rpc url is not configured", errors.Params{"coin": p.CoinIndex})
+	}
+	block, err := p.rpcClient.GetPendingBlock(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return NormalizeRpcTxs(block.Transactions, p.CoinIndex), nil
+}
+
+// NormalizeRpcTxs converts the node transactions into pending transactions,
+// the node doesn't know the token metadata so token transfers are returned as contract calls
+func NormalizeRpcTxs(srcTxs []RpcTx, coinIndex uint) blockatlas.TxPage {
+	txs := make(blockatlas.TxPage, 0, len(srcTxs))
+	for _, srcTx := range srcTxs {
+		tx, ok := NormalizeRpcTx(srcTx, coinIndex)
+		if !ok {
+			continue
+		}
+		txs = append(txs, tx)
+	}
+	return txs
+}
+
+func NormalizeRpcTx(srcTx RpcTx, coinIndex uint) (blockatlas.Tx, bool) {
+	value, err := numbers.HexToDecimal(srcTx.Value)
+	if err != nil {
+		return blockatlas.Tx{}, false
+	}
+	nonce, err := strconv.ParseUint(srcTx.Nonce, 0, 64)
+	if err != nil {
+		return blockatlas.Tx{}, false
+	}
+	gas, err := numbers.HexToDecimal(srcTx.Gas)
+	if err != nil {
+		return blockatlas.Tx{}, false
+	}
+	gasPrice, err := numbers.HexToDecimal(srcTx.GasPrice)
+	if err != nil {
+		return blockatlas.Tx{}, false
+	}
+
+	tx := blockatlas.Tx{
+		ID:       srcTx.Hash,
+		Coin:     coinIndex,
+		From:     srcTx.From,
+		To:       srcTx.To,
+		Fee:      blockatlas.Amount(calcFee(gasPrice, gas)),
+		Status:   blockatlas.StatusPending,
+		Sequence: nonce,
+	}
+	if srcTx.Input == "" || srcTx.Input == "0x" {
+		tx.Meta = blockatlas.Transfer{
+			Value:    blockatlas.Amount(value),
+			Symbol:   coin.Coins[coinIndex].Symbol,
+			Decimals: coin.Coins[coinIndex].Decimals,
+		}
+	} else {
+		tx.Meta = blockatlas.ContractCall{
+			Input: srcTx.Input,
+			Value: value,
+		}
+	}
+	return tx, true
+}
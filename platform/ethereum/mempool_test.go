@@ -0,0 +1,46 @@
+package ethereum
+
+import (
+	"github.com/stretchr/testify/assert"
+	"github.com/trustwallet/blockatlas/coin"
+	"github.com/trustwallet/blockatlas/pkg/blockatlas"
+	"testing"
+)
+
+func TestNormalizeRpcTx(t *testing.T) {
+	srcTx := RpcTx{
+		Hash:     "0x88df016429689c079f3b2f6ad39fa052532c56795b733da78a91ebe6a713944b",
+		From:     "0xa7d9ddbe1f17865597fbd27ec712455208b6b76d",
+		To:       "0xf02c1c8e6114b1dbe8937a39260b5b0a374432bb",
+		Value:    "0xf3dbb76162000",
+		Gas:      "0xc350",
+		GasPrice: "0x4a817c800",
+		Nonce:    "0x15",
+		Input:    "0x",
+	}
+	tx, ok := NormalizeRpcTx(srcTx, coin.ETC)
+	assert.True(t, ok)
+	assert.Equal(t, blockatlas.Tx{
+		ID:       "0x88df016429689c079f3b2f6ad39fa052532c56795b733da78a91ebe6a713944b",
+		Coin:     coin.ETC,
+		From:     "0xa7d9ddbe1f17865597fbd27ec712455208b6b76d",
+		To:       "0xf02c1c8e6114b1dbe8937a39260b5b0a374432bb",
+		Fee:      "1000000000000000",
+		Status:   blockatlas.StatusPending,
+		Sequence: 21,
+		Meta: blockatlas.Transfer{
+			Value:    "4290000000000000",
+			Symbol:   "ETC",
+			Decimals: 18,
+		},
+	}, tx)
+
+	srcTx.Input = "0xa9059cbb"
+	tx, ok = NormalizeRpcTx(srcTx, coin.ETC)
+	assert.True(t, ok)
+	assert.Equal(t, blockatlas.ContractCall{Input: "0xa9059cbb", Value: "4290000000000000"}, tx.Meta)
+
+	srcTx.Nonce = ""
+	_, ok = NormalizeRpcTx(srcTx, coin.ETC)
+	assert.False(t, ok)
+}
@@ -109,3 +109,33 @@ type AssetContract struct {
 	Type         string `json:"schema_name"`
 	Version      string `json:"nft_version"`
 }
+
+// CallRequest is the eth_estimateGas call object, the quantities are hex encoded
+type CallRequest struct {
+	From  string `json:"from,omitempty"`
+	To    string `json:"to,omitempty"`
+	Value string `json:"value,omitempty"`
+	Data  string `json:"data,omitempty"`
+}
+
+type RpcBlock struct {
+	Hash         string  `json:"hash"`
+	Transactions []RpcTx `json:"transactions"`
+}
+
+// RpcBlockHeader is the block of eth_getBlockByNumber without its transactions
+type RpcBlockHeader struct {
+	Hash       string `json:"hash"`
+	ParentHash string `json:"parentHash"`
+}
+
+type RpcTx struct {
+	Hash     string `json:"hash"`
+	From     string `json:"from"`
+	To       string `json:"to"`
+	Value    string `json:"value"`
+	Gas      string `json:"gas"`
+	GasPrice string `json:"gasPrice"`
+	Nonce    string `json:"nonce"`
+	Input    string `json:"input"`
+}
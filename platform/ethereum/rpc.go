@@ -0,0 +1,52 @@
+package ethereum
+
+import (
+	"context"
+	"github.com/trustwallet/blockatlas/pkg/blockatlas"
+	"github.com/trustwallet/blockatlas/pkg/errors"
+	"math/big"
+	"strconv"
+)
+
+type RpcClient struct {
+	blockatlas.Request
+}
+
+func (c *RpcClient) GasPrice(ctx context.Context) (*big.Int, error) {
+	var price string
+	err := c.RpcCallWithContext(ctx, &price, "eth_gasPrice", []string{})
+	if err != nil {
+		return nil, err
+	}
+	return hexToBig(price)
+}
+
+func (c *RpcClient) EstimateGas(ctx context.Context, call CallRequest) (*big.Int, error) {
+	var gas string
+	err := c.RpcCallWithContext(ctx, &gas, "eth_estimateGas", []CallRequest{call})
+	if err != nil {
+		return nil, err
+	}
+	return hexToBig(gas)
+}
+
+// GetPendingBlock returns the block the node is building from its transaction pool
+func (c *RpcClient) GetPendingBlock(ctx context.Context) (block RpcBlock, err error) {
+	err = c.RpcCallWithContext(ctx, &block, "eth_getBlockByNumber", []interface{}{"pending", true})
+	return
+}
+
+// GetBlockHeader returns the hashes of the block, they are empty if the node doesn't have the block yet
+func (c *RpcClient) GetBlockHeader(ctx context.Context, num int64) (header RpcBlockHeader, err error) {
+	number := "0x" + strconv.FormatInt(num, 16)
+	err = c.RpcCallWithContext(ctx, &header, "eth_getBlockByNumber", []interface{}{number, false})
+	return
+}
+
+func hexToBig(hex string) (*big.Int, error) {
+	value, ok := new(big.Int).SetString(hex, 0)
+	if !ok {
+		return nil, errors.E("invalid hex quantity", errors.Params{"value": hex})
+	}
+	return value, nil
+}
@@ -1,6 +1,7 @@
 package ethereum
 
 import (
+	"context"
 	"github.com/gin-gonic/gin"
 	"github.com/trustwallet/blockatlas/coin"
 	"github.com/trustwallet/blockatlas/pkg/address"
@@ -24,9 +25,9 @@ func (p *Platform) getTransactions(c *gin.Context) {
 	var err error
 
 	if token != "" {
-		srcPage, err = p.client.GetTxsWithContract(address, token)
+		srcPage, err = p.client.GetTxsWithContract(c.Request.Context(), address, token)
 	} else {
-		srcPage, err = p.client.GetTxs(address)
+		srcPage, err = p.client.GetTxs(c.Request.Context(), address)
 	}
 
 	if apiError(c, err) {
@@ -43,6 +44,48 @@ func (p *Platform) getTransactions(c *gin.Context) {
 	c.JSON(http.StatusOK, &page)
 }
 
+func (p *Platform) GetTxsByAddressFromCursor(ctx context.Context, address string, cursor *blockatlas.TxCursor) (blockatlas.TxCursorPage, error) {
+	return p.getTxsFromCursor(ctx, address, "", cursor)
+}
+
+func (p *Platform) GetTokenTxsByAddressFromCursor(ctx context.Context, address, token string, cursor *blockatlas.TxCursor) (blockatlas.TxCursorPage, error) {
+	return p.getTxsFromCursor(ctx, address, token, cursor)
+}
+
+// getTxsFromCursor pages the API from the block of the cursor
+func (p *Platform) getTxsFromCursor(ctx context.Context, address, token string, cursor *blockatlas.TxCursor) (blockatlas.TxCursorPage, error) {
+	return blockatlas.FetchCursorPage(cursor, blockatlas.TxPerPage, func(to uint64, n int) (blockatlas.TxPage, bool, error) {
+		srcPage, err := p.client.GetTxsUpTo(ctx, address, token, to, n, blockatlas.TxPerPage)
+		if err != nil {
+			return nil, false, err
+		}
+		var txs blockatlas.TxPage
+		for _, srcTx := range srcPage.Docs {
+			txs = AppendTxs(txs, &srcTx, p.CoinIndex)
+		}
+		return txs, len(srcPage.Docs) < blockatlas.TxPerPage, nil
+	})
+}
+
+func (p *Platform) GetTxByHash(hash string) (*blockatlas.Tx, error) {
+	return p.GetTxByHashWithContext(context.Background(), hash)
+}
+
+func (p *Platform) GetTxByHashWithContext(ctx context.Context, hash string) (*blockatlas.Tx, error) {
+	srcTx, err := p.client.GetTx(ctx, hash)
+	if err != nil {
+		return nil, err
+	}
+	if srcTx == nil || srcTx.ID == "" {
+		return nil, blockatlas.ErrNotFound
+	}
+	txs := AppendTxs(nil, srcTx, p.CoinIndex)
+	if len(txs) == 0 {
+		return nil, blockatlas.ErrNotFound
+	}
+	return &txs[len(txs)-1], nil
+}
+
 func extractBase(srcTx *Doc, coinIndex uint) (base blockatlas.Tx, ok bool) {
 	var status blockatlas.Status
 	var errReason string
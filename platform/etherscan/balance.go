@@ -1,11 +1,44 @@
 package etherscan
 
 import (
+	"context"
 	"github.com/gin-gonic/gin"
+	"github.com/trustwallet/blockatlas/pkg/blockatlas"
 	"github.com/trustwallet/blockatlas/pkg/logger"
 	"net/http"
 )
 
+func (p *Platform) GetBalance(address string) (*blockatlas.Balance, error) {
+	return p.GetBalanceWithContext(context.Background(), address)
+}
+
+func (p *Platform) GetBalanceWithContext(ctx context.Context, address string) (*blockatlas.Balance, error) {
+	balance, err := p.client.GetBalance(ctx, address)
+	if err != nil {
+		return nil, err
+	}
+	return &blockatlas.Balance{
+		Coin:    p.CoinIndex,
+		Address: address,
+		Balance: balance,
+	}, nil
+}
+
+func (p *Platform) GetTokenBalance(address, token string) (*blockatlas.TokenBalance, error) {
+	return p.GetTokenBalanceWithContext(context.Background(), address, token)
+}
+
+func (p *Platform) GetTokenBalanceWithContext(ctx context.Context, address, token string) (*blockatlas.TokenBalance, error) {
+	balance, err := p.client.GetTokenBalance(ctx, address, token)
+	if err != nil {
+		return nil, err
+	}
+	return &blockatlas.TokenBalance{
+		TokenID: token,
+		Balance: balance,
+	}, nil
+}
+
 func (p *Platform) getBalance(c *gin.Context) {
 	token := c.Query("token")
 	address := c.Param("address")
@@ -13,10 +46,9 @@ func (p *Platform) getBalance(c *gin.Context) {
 	var balance string
 
 	if token != "" {
-		//srcPage, err = p.client.GetTokenBalance(address, token)
-		balance, err = p.client.GetBalance(address)
+		balance, err = p.client.GetTokenBalance(c.Request.Context(), address, token)
 	} else {
-		balance, err = p.client.GetBalance(address)
+		balance, err = p.client.GetBalance(c.Request.Context(), address)
 	}
 
 	if apiError(c, err) {
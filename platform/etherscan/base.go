@@ -4,19 +4,22 @@ import (
 	"github.com/gin-gonic/gin"
 	"github.com/trustwallet/blockatlas/coin"
 	"github.com/trustwallet/blockatlas/pkg/blockatlas"
+	"github.com/trustwallet/blockatlas/platform/ethereum"
 )
 
 type Platform struct {
 	CoinIndex uint
 	RpcURL    string
 	client    Client
+	rpcClient ethereum.RpcClient
 }
 
 func Init(coin uint, api, rpc string) *Platform {
 	return &Platform{
 		CoinIndex: coin,
-		RpcURL:    rpc,
+		RpcURL:    blockatlas.PrimaryEndpoint(rpc),
 		client:    Client{blockatlas.InitClient(api), 10},
+		rpcClient: ethereum.RpcClient{Request: blockatlas.InitClient(rpc)},
 	}
 }
 
@@ -1,6 +1,7 @@
 package etherscan
 
 import (
+	"context"
 	"github.com/gin-gonic/gin"
 	"github.com/trustwallet/blockatlas/pkg/blockatlas"
 	"net/http"
@@ -30,19 +31,32 @@ func (p *Platform) getBlockByNumber(c *gin.Context) {
 }
 
 func (p *Platform) CurrentBlockNumber() (int64, error) {
-	return p.client.CurrentBlockNumber()
+	return p.CurrentBlockNumberWithContext(context.Background())
+}
+
+func (p *Platform) CurrentBlockNumberWithContext(ctx context.Context) (int64, error) {
+	return p.client.CurrentBlockNumber(ctx)
 }
 
 func (p *Platform) GetBlockByNumber(num int64) (*blockatlas.Block, error) {
-	if srcPage, err := p.client.GetBlockByNumber(num); err == nil {
+	return p.GetBlockByNumberWithContext(context.Background(), num)
+}
+
+func (p *Platform) GetBlockByNumberWithContext(ctx context.Context, num int64) (*blockatlas.Block, error) {
+	if srcPage, err := p.client.GetBlockByNumber(ctx, num); err == nil {
 		var txs []blockatlas.Tx
 		for _, srcTx := range srcPage.Block.Docs {
 			txs = AppendTxs(txs, &srcTx, p.CoinIndex)
 		}
+		id := srcPage.Block.Hash
+		if id == "" {
+			id = strconv.FormatInt(num, 10)
+		}
 		return &blockatlas.Block{
-			Number: num,
-			ID:     strconv.FormatInt(num, 10),
-			Txs:    txs,
+			Number:   num,
+			ID:       id,
+			ParentID: srcPage.Block.ParentHash,
+			Txs:      txs,
 		}, nil
 	} else {
 		return nil, err
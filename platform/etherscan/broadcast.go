@@ -0,0 +1,15 @@
+package etherscan
+
+import (
+	"context"
+	"strings"
+)
+
+// BroadcastTransaction sends the hex encoded signed transaction, with or without the 0x prefix
+func (p *Platform) BroadcastTransaction(raw string) (string, error) {
+	return p.BroadcastTransactionWithContext(context.Background(), raw)
+}
+
+func (p *Platform) BroadcastTransactionWithContext(ctx context.Context, raw string) (string, error) {
+	return p.client.SendTransaction(ctx, strings.TrimPrefix(raw, "0x"))
+}
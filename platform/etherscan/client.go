@@ -1,6 +1,7 @@
 package etherscan
 
 import (
+	"context"
 	"errors"
 	"github.com/trustwallet/blockatlas/pkg/blockatlas"
 	"github.com/trustwallet/blockatlas/pkg/logger"
@@ -15,29 +16,45 @@ type Client struct {
 	MaxRetries int
 }
 
-func (c *Client) GetTxs(address string) (*Page, error) {
-	return c.getTxs(url.Values{"address": {address}, "module": {"account"}, "action": {"txlist"}})
+func (c *Client) GetTxs(ctx context.Context, address string) (*Page, error) {
+	return c.getTxs(ctx, url.Values{"address": {address}, "module": {"account"}, "action": {"txlist"}})
 }
 
-func (c *Client) GetTxsWithContract(address, contract string) (*Page, error) {
-	return c.getTxs(url.Values{"address": {address}, "contract": {contract}})
+// GetTxsUpTo returns a page of the newest transactions of the address up to the block, all of them if the block is 0
+func (c *Client) GetTxsUpTo(ctx context.Context, address string, block uint64, page, limit int) (*Page, error) {
+	query := url.Values{
+		"address": {address},
+		"module":  {"account"},
+		"action":  {"txlist"},
+		"sort":    {"desc"},
+		"page":    {strconv.Itoa(page)},
+		"offset":  {strconv.Itoa(limit)},
+	}
+	if block > 0 {
+		query.Set("endblock", strconv.FormatUint(block, 10))
+	}
+	return c.getTxs(ctx, query)
 }
 
-func (c *Client) getTxs(query url.Values) (page *Page, err error) {
-	err = c.Get(&page, "api", query)
+func (c *Client) GetTxsWithContract(ctx context.Context, address, contract string) (*Page, error) {
+	return c.getTxs(ctx, url.Values{"address": {address}, "contract": {contract}})
+}
+
+func (c *Client) getTxs(ctx context.Context, query url.Values) (page *Page, err error) {
+	err = c.GetWithContext(ctx, &page, "api", query)
 	return
 }
 
-func (c *Client) GetBlockByNumber(num int64) (page *BlockPage, err error) {
+func (c *Client) GetBlockByNumber(ctx context.Context, num int64) (page *BlockPage, err error) {
 	values := url.Values{"module": {"proxy"}, "action": {"eth_getBlockByNumber"}, "tag": {"0x" + strconv.FormatInt(num, 16)}, "boolean": {"true"}}
-	err = c.Get(&page, "api", values)
+	err = c.GetWithContext(ctx, &page, "api", values)
 	return
 }
 
-func (c *Client) CurrentBlockNumber() (int64, error) {
+func (c *Client) CurrentBlockNumber(ctx context.Context) (int64, error) {
 	var nodeInfo NodeInfo
 	values := url.Values{"module": {"proxy"}, "action": {"eth_blockNumber"}}
-	err := c.Get(&nodeInfo, "api", values)
+	err := c.GetWithContext(ctx, &nodeInfo, "api", values)
 	if err != nil {
 		return 0, err
 	}
@@ -49,13 +66,13 @@ func (c *Client) CurrentBlockNumber() (int64, error) {
 
 }
 
-func (c *Client) EstimateGas(tx string, to string, value int64) (int64, error) {
+func (c *Client) EstimateGas(ctx context.Context, tx string, to string, value int64) (int64, error) {
 	var gasInfo StringResultPage
 	values := url.Values{"module": {"proxy"},
 		"action": {"eth_estimateGas"}, "to": {to},
 		"value": {"0x" + strconv.FormatInt(value, 16)},
 		"data":  {tx}}
-	err := c.Get(&gasInfo, "api", values)
+	err := c.GetWithContext(ctx, &gasInfo, "api", values)
 	if err != nil {
 		return 0, err
 	}
@@ -66,7 +83,7 @@ func (c *Client) EstimateGas(tx string, to string, value int64) (int64, error) {
 		retryCounter < c.MaxRetries {
 		logger.Info("Sleeping 1 second before retry")
 		time.Sleep(3 * time.Second)
-		err := c.Get(&gasInfo, "api", values)
+		err := c.GetWithContext(ctx, &gasInfo, "api", values)
 		if err != nil {
 			return 0, err
 		}
@@ -81,12 +98,14 @@ func (c *Client) EstimateGas(tx string, to string, value int64) (int64, error) {
 
 }
 
-func (c *Client) SendTransaction(tx string) (string, error) {
+func (c *Client) SendTransaction(ctx context.Context, tx string) (string, error) {
 	var txInfo StringResultPage
 	values := url.Values{"module": {"proxy"},
 		"action": {"eth_sendRawTransaction"},
 		"hex":    {"0x" + tx}}
-	err := c.Get(&txInfo, "api", values)
+	req := c.Request
+	req.DecodeErrors = true
+	err := req.GetWithContext(ctx, &txInfo, "api", values)
 	if err != nil {
 		return "", err
 	}
@@ -96,7 +115,7 @@ func (c *Client) SendTransaction(tx string) (string, error) {
 		if retryCounter < c.MaxRetries {
 			logger.Info("Sleeping 3 second before retry")
 			time.Sleep(3 * time.Second)
-			err := c.Get(&txInfo, "api", values)
+			err := req.GetWithContext(ctx, &txInfo, "api", values)
 			if err != nil {
 				return "", err
 			}
@@ -105,15 +124,18 @@ func (c *Client) SendTransaction(tx string) (string, error) {
 			return "", errors.New("Error sending transaction")
 		}
 	}
+	if txInfo.Error != nil {
+		return "", blockatlas.NewBroadcastError(txInfo.Error.Message)
+	}
 
 	return txInfo.Result, nil
 
 }
 
-func (c *Client) GasPrice() (int64, error) {
+func (c *Client) GasPrice(ctx context.Context) (int64, error) {
 	var gasInfo StringResultPage
 	values := url.Values{"module": {"proxy"}, "action": {"eth_gasPrice"}}
-	err := c.Get(&gasInfo, "api", values)
+	err := c.GetWithContext(ctx, &gasInfo, "api", values)
 	if err != nil {
 		return 0, err
 	}
@@ -124,10 +146,11 @@ func (c *Client) GasPrice() (int64, error) {
 		retryCounter < c.MaxRetries {
 		logger.Info("Sleeping 1 second before retry")
 		time.Sleep(1 * time.Second)
-		err := c.Get(&gasInfo, "api", values)
+		err := c.GetWithContext(ctx, &gasInfo, "api", values)
 		if err != nil {
 			return 0, err
 		}
+		retryCounter += 1
 	}
 
 	if block_number, err := hexToInt(gasInfo.Result); err != nil {
@@ -137,11 +160,11 @@ func (c *Client) GasPrice() (int64, error) {
 	}
 }
 
-func (c *Client) GetTokens(address string) (tp *TokenPage, err error) {
+func (c *Client) GetTokens(ctx context.Context, address string) (tp *TokenPage, err error) {
 	query := url.Values{
 		"address": {address},
 	}
-	err = c.Get(&tp, "tokens", query)
+	err = c.GetWithContext(ctx, &tp, "tokens", query)
 
 	return
 }
@@ -154,10 +177,25 @@ func hexToInt(hex string) (int64, error) {
 	return strconv.ParseInt(nonceStr, 10, 64)
 }
 
-func (c *Client) GetBalance(address string) (balance string, err error) {
-	var balanceInfo StringResultPage
+func (c *Client) GetBalance(ctx context.Context, address string) (balance string, err error) {
 	values := url.Values{"module": {"account"}, "action": {"balance"}, "address": {address}, "tag": {"latest"}}
-	err = c.Get(&balanceInfo, "api", values)
+	return c.getBalanceResult(ctx, values)
+}
+
+func (c *Client) GetTokenBalance(ctx context.Context, address, contract string) (balance string, err error) {
+	values := url.Values{
+		"module":          {"account"},
+		"action":          {"tokenbalance"},
+		"contractaddress": {contract},
+		"address":         {address},
+		"tag":             {"latest"},
+	}
+	return c.getBalanceResult(ctx, values)
+}
+
+func (c *Client) getBalanceResult(ctx context.Context, values url.Values) (balance string, err error) {
+	var balanceInfo StringResultPage
+	err = c.GetWithContext(ctx, &balanceInfo, "api", values)
 	if err != nil {
 		return
 	}
@@ -167,10 +205,14 @@ func (c *Client) GetBalance(address string) (balance string, err error) {
 		retryCounter < c.MaxRetries {
 		logger.Info("Sleeping 1 second before retry")
 		time.Sleep(1 * time.Second)
-		err := c.Get(&balanceInfo, "api", values)
+		err := c.GetWithContext(ctx, &balanceInfo, "api", values)
 		if err != nil {
 			return "0", err
 		}
+		retryCounter += 1
+	}
+	if balanceInfo.Message == "NOTOK" {
+		return "0", errors.New("Error getting balance: " + balanceInfo.Result)
 	}
 
 	return balanceInfo.Result, nil
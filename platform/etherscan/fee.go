@@ -0,0 +1,37 @@
+package etherscan
+
+import (
+	"context"
+	"github.com/trustwallet/blockatlas/pkg/blockatlas"
+	"math/big"
+	"strconv"
+)
+
+func (p *Platform) GetFee(req blockatlas.FeeRequest) (*blockatlas.Fee, error) {
+	return p.GetFeeWithContext(context.Background(), req)
+}
+
+func (p *Platform) GetFeeWithContext(ctx context.Context, req blockatlas.FeeRequest) (*blockatlas.Fee, error) {
+	gasPrice, err := p.client.GasPrice(ctx)
+	if err != nil {
+		return nil, err
+	}
+	fee := blockatlas.NewGasPriceFee(p.CoinIndex, big.NewInt(gasPrice))
+	if req.To == "" {
+		return fee, nil
+	}
+
+	var value int64
+	if req.Value != "" {
+		value, err = strconv.ParseInt(req.Value, 10, 64)
+		if err != nil {
+			return nil, err
+		}
+	}
+	gasLimit, err := p.client.EstimateGas(ctx, req.Data, req.To, value)
+	if err != nil {
+		return nil, err
+	}
+	fee.GasLimit = strconv.FormatInt(gasLimit, 10)
+	return fee, nil
+}
@@ -7,7 +7,7 @@ import (
 )
 
 func (p *Platform) getGasPrice(c *gin.Context) {
-	gasPrice, err := p.client.GasPrice()
+	gasPrice, err := p.client.GasPrice(c.Request.Context())
 	if apiError(c, err) {
 		return
 	}
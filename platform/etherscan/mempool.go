@@ -0,0 +1,24 @@
+package etherscan
+
+import (
+	"context"
+	"github.com/trustwallet/blockatlas/pkg/blockatlas"
+	"github.com/trustwallet/blockatlas/pkg/errors"
+	"github.com/trustwallet/blockatlas/platform/ethereum"
+)
+
+// GetMempoolTxs reads the pending block from the node, Etherscan doesn't expose the transaction pool
+func (p *Platform) GetMempoolTxs() (blockatlas.TxPage, error) {
+	return p.GetMempoolTxsWithContext(context.Background())
+}
+
+func (p *Platform) GetMempoolTxsWithContext(ctx context.Context) (blockatlas.TxPage, error) {
+	if p.RpcURL == "" {
+		return nil, errors.E("rpc url is not configured", errors.Params{"coin": p.CoinIndex})
+	}
+	block, err := p.rpcClient.GetPendingBlock(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return ethereum.NormalizeRpcTxs(block.Transactions, p.CoinIndex), nil
+}
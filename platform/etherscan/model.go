@@ -23,9 +23,16 @@ type BlockPage struct {
 }
 
 type StringResultPage struct {
-	Status  string `josn:"status"`
+	Status  string         `josn:"status"`
+	Message string         `json:"message"`
+	Result  string         `json:"result"`
+	Error   *ProxyRpcError `json:"error,omitempty"`
+}
+
+// ProxyRpcError is the node error forwarded by the proxy module
+type ProxyRpcError struct {
+	Code    int    `json:"code"`
 	Message string `json:"message"`
-	Result  string `json:"result"`
 }
 
 type InterfaceResultPage struct {
@@ -33,8 +40,10 @@ type InterfaceResultPage struct {
 }
 
 type Block struct {
-	Miner string `json:"miner"`
-	Docs  []Doc  `json:"transactions"`
+	Hash       string `json:"hash"`
+	ParentHash string `json:"parentHash"`
+	Miner      string `json:"miner"`
+	Docs       []Doc  `json:"transactions"`
 }
 
 type Doc struct {
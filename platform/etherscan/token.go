@@ -1,11 +1,16 @@
 package etherscan
 
 import (
+	"context"
 	"github.com/trustwallet/blockatlas/pkg/blockatlas"
 )
 
 func (p *Platform) GetTokenListByAddress(address string) (blockatlas.TokenPage, error) {
-	account, err := p.client.GetTokens(address)
+	return p.GetTokenListByAddressWithContext(context.Background(), address)
+}
+
+func (p *Platform) GetTokenListByAddressWithContext(ctx context.Context, address string) (blockatlas.TokenPage, error) {
+	account, err := p.client.GetTokens(ctx, address)
 	if err != nil {
 		return nil, err
 	}
@@ -1,6 +1,7 @@
 package etherscan
 
 import (
+	"context"
 	"github.com/gin-gonic/gin"
 	"github.com/trustwallet/blockatlas/coin"
 	"github.com/trustwallet/blockatlas/pkg/address"
@@ -30,7 +31,7 @@ func (p *Platform) getEstimatedGas(c *gin.Context) {
 		return
 	}
 
-	gasAmount, err := p.client.EstimateGas(txrequest.Data, txrequest.To, txrequest.Value)
+	gasAmount, err := p.client.EstimateGas(c.Request.Context(), txrequest.Data, txrequest.To, txrequest.Value)
 	if apiError(c, err) {
 		return
 	}
@@ -46,7 +47,7 @@ func (p *Platform) sendTransaction(c *gin.Context) {
 		return
 	}
 
-	txHash, err := p.client.SendTransaction(txrequest.Tx)
+	txHash, err := p.client.SendTransaction(c.Request.Context(), txrequest.Tx)
 	if apiError(c, err) {
 		return
 	}
@@ -61,9 +62,9 @@ func (p *Platform) getTransactions(c *gin.Context) {
 	var err error
 
 	if token != "" {
-		srcPage, err = p.client.GetTxsWithContract(address, token)
+		srcPage, err = p.client.GetTxsWithContract(c.Request.Context(), address, token)
 	} else {
-		srcPage, err = p.client.GetTxs(address)
+		srcPage, err = p.client.GetTxs(c.Request.Context(), address)
 	}
 
 	if apiError(c, err) {
@@ -80,6 +81,20 @@ func (p *Platform) getTransactions(c *gin.Context) {
 	c.JSON(http.StatusOK, &page)
 }
 
+func (p *Platform) GetTxsByAddressFromCursor(ctx context.Context, address string, cursor *blockatlas.TxCursor) (blockatlas.TxCursorPage, error) {
+	return blockatlas.FetchCursorPage(cursor, blockatlas.TxPerPage, func(to uint64, n int) (blockatlas.TxPage, bool, error) {
+		srcPage, err := p.client.GetTxsUpTo(ctx, address, to, n, blockatlas.TxPerPage)
+		if err != nil {
+			return nil, false, err
+		}
+		var txs blockatlas.TxPage
+		for _, srcTx := range srcPage.Docs {
+			txs = AppendTxs(txs, &srcTx, p.CoinIndex)
+		}
+		return txs, len(srcPage.Docs) < blockatlas.TxPerPage, nil
+	})
+}
+
 func extractBase(srcTx *Doc, coinIndex uint) (base blockatlas.Tx, ok bool) {
 	var status blockatlas.Status
 	var errReason string
@@ -23,8 +23,9 @@ func (p *Platform) NormalizeBlock(block *BlockInfo) blockatlas.Block {
 		return blockatlas.Block{}
 	}
 	return blockatlas.Block{
-		ID:     block.Hash,
-		Number: int64(blockNumber),
-		Txs:    NormalizeTxs(block.Transactions),
+		ID:       block.Hash,
+		ParentID: block.ParentHash,
+		Number:   int64(blockNumber),
+		Txs:      NormalizeTxs(block.Transactions),
 	}
 }
@@ -23,6 +23,7 @@ type Transaction struct {
 
 type BlockInfo struct {
 	Hash         string        `json:"hash"`
+	ParentHash   string        `json:"parentHash"`
 	Number       string        `json:"number"`
 	Transactions []Transaction `json:"transactions"`
 }
@@ -0,0 +1,20 @@
+package iotex
+
+import (
+	"github.com/trustwallet/blockatlas/pkg/blockatlas"
+)
+
+func (p *Platform) GetBalance(address string) (*blockatlas.Balance, error) {
+	account, err := p.client.GetAccount(address)
+	if err != nil {
+		return nil, err
+	}
+	if account.AccountMeta == nil {
+		return nil, blockatlas.ErrNotFound
+	}
+	return &blockatlas.Balance{
+		Coin:    p.Coin().ID,
+		Address: address,
+		Balance: account.AccountMeta.Balance,
+	}, nil
+}
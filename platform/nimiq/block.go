@@ -20,8 +20,9 @@ func (p *Platform) GetBlockByNumber(num int64) (*blockatlas.Block, error) {
 // NormalizeBlock converts a Nimiq block into the generic model
 func NormalizeBlock(srcBlock *Block) blockatlas.Block {
 	return blockatlas.Block{
-		Number: srcBlock.Number,
-		ID:     srcBlock.Hash,
-		Txs:    NormalizeTxs(srcBlock.Txs),
+		Number:   srcBlock.Number,
+		ID:       srcBlock.Hash,
+		ParentID: srcBlock.ParentHash,
+		Txs:      NormalizeTxs(srcBlock.Txs),
 	}
 }
@@ -0,0 +1,31 @@
+package ontology
+
+import (
+	"github.com/trustwallet/blockatlas/pkg/blockatlas"
+	"github.com/trustwallet/blockatlas/pkg/numbers"
+)
+
+func (p *Platform) GetBalance(address string) (*blockatlas.Balance, error) {
+	acc, err := p.client.GetBalances(address)
+	if err != nil {
+		return nil, err
+	}
+	return NormalizeBalance(acc.Result, p.Coin().ID, address), nil
+}
+
+// NormalizeBalance converts the ONT balance and returns ONG as a token
+func NormalizeBalance(balances Balances, coinIndex uint, address string) *blockatlas.Balance {
+	balance := blockatlas.Balance{Coin: coinIndex, Address: address, Balance: "0"}
+	if ont := balances.getBalance(AssetONT); ont != nil {
+		balance.Balance = ont.Balance
+	}
+	if ong := balances.getBalance(AssetONG); ong != nil {
+		balance.Tokens = append(balance.Tokens, blockatlas.TokenBalance{
+			TokenID:  string(AssetONG),
+			Symbol:   "ONG",
+			Decimals: ONGDecimals,
+			Balance:  numbers.DecimalExp(ong.Balance, ONGDecimals),
+		})
+	}
+	return &balance
+}
@@ -0,0 +1,20 @@
+package ontology
+
+import (
+	"github.com/stretchr/testify/assert"
+	"github.com/trustwallet/blockatlas/coin"
+	"github.com/trustwallet/blockatlas/pkg/blockatlas"
+	"testing"
+)
+
+func TestNormalizeBalance(t *testing.T) {
+	balances := Balances{
+		{Balance: "12", AssetName: AssetONT},
+		{Balance: "0.059846818", AssetName: AssetONG},
+	}
+	balance := NormalizeBalance(balances, coin.ONT, "AUyL4TZ1zFEcSKDJrjFnD7vsq5iFZMZqT7")
+	assert.Equal(t, "12", balance.Balance)
+	assert.Equal(t, []blockatlas.TokenBalance{
+		{TokenID: "ong", Symbol: "ONG", Decimals: ONGDecimals, Balance: "59846818"},
+	}, balance.Tokens)
+}
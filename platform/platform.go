@@ -30,6 +30,7 @@ import (
 	"github.com/trustwallet/blockatlas/platform/vechain"
 	"github.com/trustwallet/blockatlas/platform/waves"
 	"github.com/trustwallet/blockatlas/platform/zilliqa"
+	"strings"
 )
 
 const (
@@ -38,8 +39,14 @@ const (
 
 var CollectionsWhitelist map[uint]bool
 
+// GetVar returns the config value, a list is joined with commas like the lists of endpoints set by env variables
 func GetVar(name string) string {
-	return viper.GetString(name)
+	switch viper.Get(name).(type) {
+	case []interface{}, []string:
+		return strings.Join(viper.GetStringSlice(name), ",")
+	default:
+		return viper.GetString(name)
+	}
 }
 
 func GetApiVar(coinId uint) string {
@@ -63,7 +70,7 @@ func getPlatformMap() blockatlas.Platforms {
 		coin.Iotex().Handle:        iotex.Init(GetApiVar(coin.IOTX)),
 		coin.Theta().Handle:        theta.Init(GetApiVar(coin.THETA)),
 		coin.Waves().Handle:        waves.Init(GetApiVar(coin.WAVES)),
-		coin.Ripple().Handle:       ripple.Init(GetApiVar(coin.XRP)),
+		coin.Ripple().Handle:       ripple.Init(GetApiVar(coin.XRP), GetRpcVar(coin.XRP)),
 		coin.Harmony().Handle:      harmony.Init(GetApiVar(coin.ONE)),
 		coin.Vechain().Handle:      vechain.Init(GetApiVar(coin.VET)),
 		coin.Nebulas().Handle:      nebulas.Init(GetApiVar(coin.NAS)),
@@ -78,20 +85,20 @@ func getPlatformMap() blockatlas.Platforms {
 		coin.Kin().Handle:          stellar.Init(coin.KIN, GetApiVar(coin.KIN)),
 		coin.Cosmos().Handle:       cosmos.Init(coin.ATOM, GetApiVar(coin.ATOM)),
 		coin.Kava().Handle:         cosmos.Init(coin.KAVA, GetApiVar(coin.KAVA)),
-		coin.Bitcoin().Handle:      bitcoin.Init(coin.BTC, GetApiVar(coin.BTC)),
-		coin.Litecoin().Handle:     bitcoin.Init(coin.LTC, GetApiVar(coin.LTC)),
-		coin.Bitcoincash().Handle:  bitcoin.Init(coin.BCH, GetApiVar(coin.BCH)),
-		coin.Zcash().Handle:        bitcoin.Init(coin.ZEC, GetApiVar(coin.ZEC)),
-		coin.Zcoin().Handle:        bitcoin.Init(coin.XZC, GetApiVar(coin.XZC)),
-		coin.Viacoin().Handle:      bitcoin.Init(coin.VIA, GetApiVar(coin.VIA)),
-		coin.Ravencoin().Handle:    bitcoin.Init(coin.RVN, GetApiVar(coin.RVN)),
-		coin.Groestlcoin().Handle:  bitcoin.Init(coin.GRS, GetApiVar(coin.GRS)),
-		coin.Zelcash().Handle:      bitcoin.Init(coin.ZEL, GetApiVar(coin.ZEL)),
-		coin.Decred().Handle:       bitcoin.Init(coin.DCR, GetApiVar(coin.DCR)),
-		coin.Digibyte().Handle:     bitcoin.Init(coin.DGB, GetApiVar(coin.DGB)),
-		coin.Dash().Handle:         bitcoin.Init(coin.DASH, GetApiVar(coin.DASH)),
-		coin.Doge().Handle:         bitcoin.Init(coin.DOGE, GetApiVar(coin.DOGE)),
-		coin.Qtum().Handle:         bitcoin.Init(coin.QTUM, GetApiVar(coin.QTUM)),
+		coin.Bitcoin().Handle:      bitcoin.Init(coin.BTC, GetApiVar(coin.BTC), GetRpcVar(coin.BTC)),
+		coin.Litecoin().Handle:     bitcoin.Init(coin.LTC, GetApiVar(coin.LTC), GetRpcVar(coin.LTC)),
+		coin.Bitcoincash().Handle:  bitcoin.Init(coin.BCH, GetApiVar(coin.BCH), GetRpcVar(coin.BCH)),
+		coin.Zcash().Handle:        bitcoin.Init(coin.ZEC, GetApiVar(coin.ZEC), GetRpcVar(coin.ZEC)),
+		coin.Zcoin().Handle:        bitcoin.Init(coin.XZC, GetApiVar(coin.XZC), GetRpcVar(coin.XZC)),
+		coin.Viacoin().Handle:      bitcoin.Init(coin.VIA, GetApiVar(coin.VIA), GetRpcVar(coin.VIA)),
+		coin.Ravencoin().Handle:    bitcoin.Init(coin.RVN, GetApiVar(coin.RVN), GetRpcVar(coin.RVN)),
+		coin.Groestlcoin().Handle:  bitcoin.Init(coin.GRS, GetApiVar(coin.GRS), GetRpcVar(coin.GRS)),
+		coin.Zelcash().Handle:      bitcoin.Init(coin.ZEL, GetApiVar(coin.ZEL), GetRpcVar(coin.ZEL)),
+		coin.Decred().Handle:       bitcoin.Init(coin.DCR, GetApiVar(coin.DCR), GetRpcVar(coin.DCR)),
+		coin.Digibyte().Handle:     bitcoin.Init(coin.DGB, GetApiVar(coin.DGB), GetRpcVar(coin.DGB)),
+		coin.Dash().Handle:         bitcoin.Init(coin.DASH, GetApiVar(coin.DASH), GetRpcVar(coin.DASH)),
+		coin.Doge().Handle:         bitcoin.Init(coin.DOGE, GetApiVar(coin.DOGE), GetRpcVar(coin.DOGE)),
+		coin.Qtum().Handle:         bitcoin.Init(coin.QTUM, GetApiVar(coin.QTUM), GetRpcVar(coin.QTUM)),
 		coin.Gochain().Handle:      ethereum.Init(coin.GO, GetApiVar(coin.GO), GetRpcVar(coin.GO)),
 		coin.Thundertoken().Handle: ethereum.Init(coin.TT, GetApiVar(coin.TT), GetRpcVar(coin.TT)),
 		coin.Classic().Handle:      ethereum.Init(coin.ETC, GetApiVar(coin.ETC), GetRpcVar(coin.ETC)),
@@ -101,7 +108,7 @@ func getPlatformMap() blockatlas.Platforms {
 		coin.Tomochain().Handle:    ethereum.Init(coin.TOMO, GetApiVar(coin.TOMO), GetRpcVar(coin.TOMO)),
 		// coin.Ethereum().Handle:     ethereum.InitWitCollection(coin.ETH, GetApiVar(coin.ETH), GetRpcVar(coin.ETH), GetVar("ethereum.collections_api"), GetVar("ethereum.collections_api_key")),
 		coin.Etherscan().Handle:   etherscan.Init(coin.ETH, GetApiVar(coin.ETH), GetRpcVar(coin.ETH)),
-		coin.Bitcointest().Handle: bitcoin.Init(coin.BTCT, GetApiVar(coin.BTCT)),
+		coin.Bitcointest().Handle: bitcoin.Init(coin.BTCT, GetApiVar(coin.BTCT), GetRpcVar(coin.BTCT)),
 	}
 }
 
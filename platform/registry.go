@@ -14,6 +14,15 @@ var (
 	// BlockAPIs contain platforms with block services
 	BlockAPIs map[string]blockatlas.BlockAPI
 
+	// MempoolAPIs contain platforms with unconfirmed transactions services
+	MempoolAPIs map[string]blockatlas.MempoolAPI
+
+	// AddressAPIs contain platforms which derive the addresses of extended public keys
+	AddressAPIs map[uint]blockatlas.AddressAPI
+
+	// BalanceAPIs contain platforms with balance services
+	BalanceAPIs map[string]blockatlas.BalanceAPI
+
 	// StakeAPIs contain platforms with staking services
 	StakeAPIs map[string]blockatlas.StakeAPI
 
@@ -45,6 +54,7 @@ func getActivePlatforms(handle string) []blockatlas.Platform {
 }
 
 func Init(platformHandle string) {
+	initUpstream()
 	platformList := getActivePlatforms(platformHandle)
 
 	// white list of collection api coins (only ETH now)
@@ -52,6 +62,9 @@ func Init(platformHandle string) {
 
 	Platforms = make(map[string]blockatlas.Platform)
 	BlockAPIs = make(map[string]blockatlas.BlockAPI)
+	MempoolAPIs = make(map[string]blockatlas.MempoolAPI)
+	AddressAPIs = make(map[uint]blockatlas.AddressAPI)
+	BalanceAPIs = make(map[string]blockatlas.BalanceAPI)
 	StakeAPIs = make(map[string]blockatlas.StakeAPI)
 	CustomAPIs = make(map[string]blockatlas.CustomAPI)
 	NamingAPIs = make(map[uint64]blockatlas.NamingServiceAPI)
@@ -64,7 +77,7 @@ func Init(platformHandle string) {
 		if !viper.IsSet(apiURL) {
 			continue
 		}
-		if viper.GetString(apiURL) == "" {
+		if GetVar(apiURL) == "" {
 			continue
 		}
 
@@ -77,9 +90,20 @@ func Init(platformHandle string) {
 			logger.Fatal("Duplicate handle", p)
 		}
 		Platforms[handle] = platform
+		setTimeout(handle)
+		setPlatform(handle)
 		if blockAPI, ok := platform.(blockatlas.BlockAPI); ok {
 			BlockAPIs[handle] = blockAPI
 		}
+		if mempoolAPI, ok := platform.(blockatlas.MempoolAPI); ok {
+			MempoolAPIs[handle] = mempoolAPI
+		}
+		if addressAPI, ok := platform.(blockatlas.AddressAPI); ok {
+			AddressAPIs[platform.Coin().ID] = addressAPI
+		}
+		if balanceAPI, ok := platform.(blockatlas.BalanceAPI); ok {
+			BalanceAPIs[handle] = balanceAPI
+		}
 		if stakeAPI, ok := platform.(blockatlas.StakeAPI); ok {
 			StakeAPIs[handle] = stakeAPI
 		}
@@ -0,0 +1,32 @@
+package ripple
+
+import (
+	"context"
+	"github.com/trustwallet/blockatlas/pkg/blockatlas"
+	"github.com/trustwallet/blockatlas/pkg/numbers"
+)
+
+func (p *Platform) GetBalance(address string) (*blockatlas.Balance, error) {
+	return p.GetBalanceWithContext(context.Background(), address)
+}
+
+func (p *Platform) GetBalanceWithContext(ctx context.Context, address string) (*blockatlas.Balance, error) {
+	res, err := p.client.GetBalances(ctx, address)
+	if err != nil {
+		return nil, err
+	}
+	if res.Result != "success" {
+		return nil, blockatlas.ErrNotFound
+	}
+	return NormalizeBalance(res.Balances, p.Coin().ID, p.Coin().Symbol, int(p.Coin().Decimals), address), nil
+}
+
+func NormalizeBalance(balances []Balance, coinIndex uint, symbol string, decimals int, address string) *blockatlas.Balance {
+	balance := blockatlas.Balance{Coin: coinIndex, Address: address, Balance: "0"}
+	for _, b := range balances {
+		if b.Currency == symbol && b.Counterparty == "" {
+			balance.Balance = numbers.DecimalExp(b.Value, decimals)
+		}
+	}
+	return &balance
+}
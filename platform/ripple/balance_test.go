@@ -0,0 +1,17 @@
+package ripple
+
+import (
+	"github.com/stretchr/testify/assert"
+	"github.com/trustwallet/blockatlas/coin"
+	"testing"
+)
+
+func TestNormalizeBalance(t *testing.T) {
+	balances := []Balance{
+		{Currency: "USD", Counterparty: "rvYAfWj5gh67oV6fW32ZzP3Aw4Eubs59B", Value: "10"},
+		{Currency: "XRP", Value: "20.500123"},
+	}
+	balance := NormalizeBalance(balances, coin.XRP, "XRP", 6, "rMQ98K56yXJbDGv49ZSmW51sLn94Xe1mu1")
+	assert.Equal(t, "20500123", balance.Balance)
+	assert.Empty(t, balance.Tokens)
+}
@@ -6,12 +6,14 @@ import (
 )
 
 type Platform struct {
-	client Client
+	client    Client
+	rpcClient RpcClient
 }
 
-func Init(api string) *Platform {
+func Init(api, rpc string) *Platform {
 	return &Platform{
-		client: Client{blockatlas.InitClient(api)},
+		client:    Client{blockatlas.InitClient(api)},
+		rpcClient: RpcClient{blockatlas.InitClient(rpc)},
 	}
 }
 
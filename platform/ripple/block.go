@@ -1,15 +1,24 @@
 package ripple
 
 import (
+	"context"
 	"github.com/trustwallet/blockatlas/pkg/blockatlas"
 )
 
 func (p *Platform) CurrentBlockNumber() (int64, error) {
-	return p.client.GetCurrentBlock()
+	return p.CurrentBlockNumberWithContext(context.Background())
+}
+
+func (p *Platform) CurrentBlockNumberWithContext(ctx context.Context) (int64, error) {
+	return p.client.GetCurrentBlock(ctx)
 }
 
 func (p *Platform) GetBlockByNumber(num int64) (*blockatlas.Block, error) {
-	if srcBlock, err := p.client.GetBlockByNumber(num); err == nil {
+	return p.GetBlockByNumberWithContext(context.Background(), num)
+}
+
+func (p *Platform) GetBlockByNumberWithContext(ctx context.Context, num int64) (*blockatlas.Block, error) {
+	if srcBlock, err := p.client.GetBlockByNumber(ctx, num); err == nil {
 		txs := NormalizeTxs(srcBlock)
 		return &blockatlas.Block{
 			Number: num,
@@ -0,0 +1,50 @@
+package ripple
+
+import (
+	"context"
+	"github.com/trustwallet/blockatlas/pkg/blockatlas"
+	"strings"
+)
+
+func (p *Platform) BroadcastTransaction(raw string) (string, error) {
+	return p.BroadcastTransactionWithContext(context.Background(), raw)
+}
+
+func (p *Platform) BroadcastTransactionWithContext(ctx context.Context, raw string) (string, error) {
+	res, err := p.rpcClient.Submit(ctx, raw)
+	if err != nil {
+		return "", err
+	}
+	return NormalizeSubmitResult(res)
+}
+
+// NormalizeSubmitResult returns the hash of the transaction accepted or queued by the server
+// and maps the engine result codes to the broadcast errors otherwise
+func NormalizeSubmitResult(res SubmitResult) (string, error) {
+	if res.Status != "success" {
+		message := res.ErrorMessage
+		if message == "" {
+			message = res.Error
+		}
+		return "", &blockatlas.BroadcastError{Err: blockatlas.ErrInvalidTx, Message: message}
+	}
+	code := res.EngineResult
+	message := code + ": " + res.EngineResultMessage
+	switch {
+	case code == "tesSUCCESS", code == "terQUEUED":
+		return res.TxJson.Hash, nil
+	case code == "tefPAST_SEQ":
+		return "", &blockatlas.BroadcastError{Err: blockatlas.ErrNonceTooLow, Message: message}
+	case code == "tefALREADY":
+		return "", &blockatlas.BroadcastError{Err: blockatlas.ErrTxAlreadyKnown, Message: message}
+	case code == "tefBAD_AUTH", code == "tefBAD_AUTH_MASTER", code == "temBAD_SIGNATURE":
+		return "", &blockatlas.BroadcastError{Err: blockatlas.ErrInvalidSignature, Message: message}
+	case code == "terINSUF_FEE_B", code == "tecUNFUNDED_PAYMENT", code == "tecINSUFF_FEE", code == "tecINSUFFICIENT_RESERVE":
+		return "", &blockatlas.BroadcastError{Err: blockatlas.ErrInsufficientFunds, Message: message}
+	case strings.HasPrefix(code, "tec"):
+		// Claimed the fee, the transaction is in the ledger
+		return res.TxJson.Hash, nil
+	default:
+		return "", &blockatlas.BroadcastError{Err: blockatlas.ErrInvalidTx, Message: message}
+	}
+}
@@ -0,0 +1,33 @@
+package ripple
+
+import (
+	"github.com/stretchr/testify/assert"
+	"github.com/trustwallet/blockatlas/pkg/blockatlas"
+	"testing"
+)
+
+func TestNormalizeSubmitResult(t *testing.T) {
+	res := SubmitResult{Status: "success", EngineResult: "tesSUCCESS"}
+	res.TxJson.Hash = "A2C8E7A6C4E6A7D4B2A8C8B3E7C6C8E1A4F9E2A3C7E8D4A2B1C3E6F7A8B9C0D1"
+	hash, err := NormalizeSubmitResult(res)
+	assert.Nil(t, err)
+	assert.Equal(t, res.TxJson.Hash, hash)
+
+	tests := []struct {
+		code string
+		want error
+	}{
+		{"tefPAST_SEQ", blockatlas.ErrNonceTooLow},
+		{"tefBAD_AUTH", blockatlas.ErrInvalidSignature},
+		{"tefALREADY", blockatlas.ErrTxAlreadyKnown},
+		{"tecUNFUNDED_PAYMENT", blockatlas.ErrInsufficientFunds},
+		{"temMALFORMED", blockatlas.ErrInvalidTx},
+	}
+	for _, tt := range tests {
+		_, err := NormalizeSubmitResult(SubmitResult{Status: "success", EngineResult: tt.code})
+		assert.Equal(t, tt.want, err.(*blockatlas.BroadcastError).Err, tt.code)
+	}
+
+	_, err = NormalizeSubmitResult(SubmitResult{Status: "error", Error: "invalidTransaction"})
+	assert.Equal(t, blockatlas.ErrInvalidTx, err.(*blockatlas.BroadcastError).Err)
+}
@@ -1,6 +1,7 @@
 package ripple
 
 import (
+	"context"
 	"fmt"
 	"github.com/trustwallet/blockatlas/pkg/blockatlas"
 	"net/url"
@@ -10,14 +11,14 @@ type Client struct {
 	blockatlas.Request
 }
 
-func (c *Client) GetTxsOfAddress(address string) ([]Tx, error) {
-	res, err := c.fetchTransactions(address, "true")
+func (c *Client) GetTxsOfAddress(ctx context.Context, address string) ([]Tx, error) {
+	res, err := c.fetchTransactions(ctx, address, "true")
 	if err != nil {
 		return nil, err
 	}
 
 	if res.Result == "error" {
-		res, err = c.fetchTransactions(address, "false")
+		res, err = c.fetchTransactions(ctx, address, "false")
 		if err != nil {
 			return nil, err
 		}
@@ -26,7 +27,7 @@ func (c *Client) GetTxsOfAddress(address string) ([]Tx, error) {
 	return res.Transactions, nil
 }
 
-func (c *Client) fetchTransactions(address, descending string) (Response, error) {
+func (c *Client) fetchTransactions(ctx context.Context, address, descending string) (Response, error) {
 	query := url.Values{
 		"type":       {"Payment"},
 		"descending": {descending},
@@ -35,23 +36,23 @@ func (c *Client) fetchTransactions(address, descending string) (Response, error)
 	uri := fmt.Sprintf("accounts/%s/transactions", url.PathEscape(address))
 
 	var res Response
-	err := c.Get(&res, uri, query)
+	err := c.GetWithContext(ctx, &res, uri, query)
 	if err != nil {
 		return Response{}, err
 	}
 	return res, nil
 }
 
-func (c *Client) GetCurrentBlock() (int64, error) {
+func (c *Client) GetCurrentBlock(ctx context.Context) (int64, error) {
 	var ledgers LedgerResponse
-	err := c.Get(&ledgers, "ledgers", nil)
+	err := c.GetWithContext(ctx, &ledgers, "ledgers", nil)
 	if err != nil {
 		return 0, err
 	}
 	return ledgers.Ledger.LedgerIndex, nil
 }
 
-func (c *Client) GetBlockByNumber(num int64) ([]Tx, error) {
+func (c *Client) GetBlockByNumber(ctx context.Context, num int64) ([]Tx, error) {
 	query := url.Values{
 		"transactions": {"true"},
 		"binary":       {"false"},
@@ -61,9 +62,20 @@ func (c *Client) GetBlockByNumber(num int64) ([]Tx, error) {
 	uri := fmt.Sprintf("ledgers/%d", num)
 
 	var res LedgerResponse
-	err := c.Get(&res, uri, query)
+	err := c.GetWithContext(ctx, &res, uri, query)
 	if err != nil {
 		return nil, err
 	}
 	return res.Ledger.Transactions, nil
 }
+
+func (c *Client) GetBalances(ctx context.Context, address string) (res BalancesResponse, err error) {
+	uri := fmt.Sprintf("accounts/%s/balances", url.PathEscape(address))
+	err = c.GetWithContext(ctx, &res, uri, url.Values{"currency": {"XRP"}})
+	return res, err
+}
+
+func (c *Client) GetTx(ctx context.Context, hash string) (res TxResponse, err error) {
+	err = c.GetWithContext(ctx, &res, fmt.Sprintf("transactions/%s", url.PathEscape(hash)), nil)
+	return res, err
+}
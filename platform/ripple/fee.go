@@ -0,0 +1,18 @@
+package ripple
+
+import (
+	"context"
+	"github.com/trustwallet/blockatlas/coin"
+	"github.com/trustwallet/blockatlas/pkg/blockatlas"
+)
+
+// baseFee is the reference transaction cost in drops
+const baseFee = "10"
+
+func (p *Platform) GetFee(req blockatlas.FeeRequest) (*blockatlas.Fee, error) {
+	return p.GetFeeWithContext(context.Background(), req)
+}
+
+func (p *Platform) GetFeeWithContext(ctx context.Context, req blockatlas.FeeRequest) (*blockatlas.Fee, error) {
+	return blockatlas.StaticFee(coin.XRP, blockatlas.FeeUnitTx, baseFee), nil
+}
@@ -20,6 +20,11 @@ type Response struct {
 	Transactions []Tx   `json:"transactions"`
 }
 
+type TxResponse struct {
+	Result      string `json:"result"`
+	Transaction Tx     `json:"transaction"`
+}
+
 type Tx struct {
 	Hash        string  `json:"hash"`
 	Date        string  `json:"date"`
@@ -60,3 +65,34 @@ type LedgerInfo struct {
 	LedgerIndex  int64 `json:"ledger_index"`
 	Transactions []Tx  `json:"transactions,omitempty"`
 }
+
+type BalancesResponse struct {
+	Result   string    `json:"result"`
+	Balances []Balance `json:"balances"`
+}
+
+type Balance struct {
+	Currency     string `json:"currency"`
+	Counterparty string `json:"counterparty,omitempty"`
+	Value        string `json:"value"`
+}
+
+type RpcRequest struct {
+	Method string        `json:"method"`
+	Params []interface{} `json:"params"`
+}
+
+type SubmitResponse struct {
+	Result SubmitResult `json:"result"`
+}
+
+type SubmitResult struct {
+	Status              string `json:"status"`
+	Error               string `json:"error,omitempty"`
+	ErrorMessage        string `json:"error_message,omitempty"`
+	EngineResult        string `json:"engine_result"`
+	EngineResultMessage string `json:"engine_result_message"`
+	TxJson              struct {
+		Hash string `json:"hash"`
+	} `json:"tx_json"`
+}
@@ -0,0 +1,23 @@
+package ripple
+
+import (
+	"context"
+	"github.com/trustwallet/blockatlas/pkg/blockatlas"
+)
+
+// RpcClient calls the rippled JSON-RPC API, which isn't JSON-RPC 2.0 compatible
+type RpcClient struct {
+	blockatlas.Request
+}
+
+func (c *RpcClient) Submit(ctx context.Context, txBlob string) (result SubmitResult, err error) {
+	req := RpcRequest{
+		Method: "submit",
+		Params: []interface{}{map[string]string{"tx_blob": txBlob}},
+	}
+	var res SubmitResponse
+	r := c.Request
+	r.DecodeErrors = true
+	err = r.PostWithContext(ctx, &res, "", req)
+	return res.Result, err
+}
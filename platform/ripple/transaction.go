@@ -1,6 +1,7 @@
 package ripple
 
 import (
+	"context"
 	"github.com/trustwallet/blockatlas/coin"
 	"github.com/trustwallet/blockatlas/pkg/blockatlas"
 	"strconv"
@@ -8,7 +9,11 @@ import (
 )
 
 func (p *Platform) GetTxsByAddress(address string) (blockatlas.TxPage, error) {
-	s, err := p.client.GetTxsOfAddress(address)
+	return p.GetTxsByAddressWithContext(context.Background(), address)
+}
+
+func (p *Platform) GetTxsByAddressWithContext(ctx context.Context, address string) (blockatlas.TxPage, error) {
+	s, err := p.client.GetTxsOfAddress(ctx, address)
 	if err != nil {
 		return nil, err
 	}
@@ -25,6 +30,25 @@ func (p *Platform) GetTxsByAddress(address string) (blockatlas.TxPage, error) {
 	return txs, nil
 }
 
+func (p *Platform) GetTxByHash(hash string) (*blockatlas.Tx, error) {
+	return p.GetTxByHashWithContext(context.Background(), hash)
+}
+
+func (p *Platform) GetTxByHashWithContext(ctx context.Context, hash string) (*blockatlas.Tx, error) {
+	res, err := p.client.GetTx(ctx, hash)
+	if err != nil {
+		return nil, err
+	}
+	if res.Result != "success" {
+		return nil, blockatlas.ErrNotFound
+	}
+	tx, ok := NormalizeTx(&res.Transaction)
+	if !ok {
+		return nil, blockatlas.ErrNotFound
+	}
+	return &tx, nil
+}
+
 func NormalizeTxs(srcTxs []Tx) (txs []blockatlas.Tx) {
 	for _, srcTx := range srcTxs {
 		tx, ok := NormalizeTx(&srcTx)
@@ -257,3 +257,14 @@ func testNormalize(t *testing.T, _test *test) {
 		assert.Equal(t, _test.expected, tx, "tx don't equal")
 	})
 }
+
+func TestTxResponse(t *testing.T) {
+	var res TxResponse
+	err := json.Unmarshal([]byte(`{"result":"success","transaction":`+paymentSrc+`}`), &res)
+	assert.Nil(t, err)
+	assert.Equal(t, "success", res.Result)
+
+	tx, ok := NormalizeTx(&res.Transaction)
+	assert.True(t, ok)
+	assert.Equal(t, paymentDst, tx)
+}
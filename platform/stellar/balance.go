@@ -0,0 +1,37 @@
+package stellar
+
+import (
+	"context"
+	"github.com/trustwallet/blockatlas/pkg/blockatlas"
+	"github.com/trustwallet/blockatlas/pkg/numbers"
+)
+
+func (p *Platform) GetBalance(address string) (*blockatlas.Balance, error) {
+	return p.GetBalanceWithContext(context.Background(), address)
+}
+
+func (p *Platform) GetBalanceWithContext(ctx context.Context, address string) (*blockatlas.Balance, error) {
+	account, err := p.client.GetAccount(ctx, address)
+	if err != nil {
+		return nil, err
+	}
+	return NormalizeBalance(account, p.CoinIndex, address)
+}
+
+// NormalizeBalance converts the native balance, Horizon always returns the amounts with 7 decimals
+func NormalizeBalance(account Account, coinIndex uint, address string) (*blockatlas.Balance, error) {
+	balance := blockatlas.Balance{Coin: coinIndex, Address: address, Balance: "0"}
+	for _, b := range account.Balances {
+		if b.AssetType != Native {
+			continue
+		}
+		value, err := numbers.DecimalToSatoshis(b.Balance)
+		if err != nil {
+			return nil, err
+		}
+		if value != "" {
+			balance.Balance = value
+		}
+	}
+	return &balance, nil
+}
@@ -0,0 +1,29 @@
+package stellar
+
+import (
+	"encoding/json"
+	"github.com/stretchr/testify/assert"
+	"github.com/trustwallet/blockatlas/coin"
+	"testing"
+)
+
+const accountResponse = `{
+	"balances": [
+		{"balance": "12.5000000", "limit": "922337203685.4775807", "asset_type": "credit_alphanum4", "asset_code": "MOBI", "asset_issuer": "GA6HCMBLTZS5VYYBCATRBRZ3BZJMAFUDKYYF6AH6MVCMGWMRDNSWJPIH"},
+		{"balance": "9999.9999800", "asset_type": "native"}
+	]
+}`
+
+func TestNormalizeBalance(t *testing.T) {
+	var account Account
+	assert.Nil(t, json.Unmarshal([]byte(accountResponse), &account))
+
+	balance, err := NormalizeBalance(account, coin.XLM, "GDKIJJIKXLOM2NRMPNQZUUYK24ZPVFC6426GZAEP3KUK6KEJLACCWNMX")
+	assert.Nil(t, err)
+	assert.Equal(t, "99999999800", balance.Balance)
+	assert.Empty(t, balance.Tokens)
+
+	empty, err := NormalizeBalance(Account{Balances: []Balance{{Balance: "0.0000000", AssetType: Native}}}, coin.XLM, "")
+	assert.Nil(t, err)
+	assert.Equal(t, "0", empty.Balance)
+}
@@ -1,25 +1,34 @@
 package stellar
 
 import (
+	"context"
 	"github.com/trustwallet/blockatlas/pkg/blockatlas"
 )
 
 func (p *Platform) CurrentBlockNumber() (int64, error) {
-	return p.client.CurrentBlockNumber()
+	return p.CurrentBlockNumberWithContext(context.Background())
+}
+
+func (p *Platform) CurrentBlockNumberWithContext(ctx context.Context) (int64, error) {
+	return p.client.CurrentBlockNumber(ctx)
 }
 
 func (p *Platform) GetBlockByNumber(num int64) (*blockatlas.Block, error) {
-	if srcBlock, err := p.client.GetBlockByNumber(num); err == nil {
-		block := p.NormalizeBlock(srcBlock)
+	return p.GetBlockByNumberWithContext(context.Background(), num)
+}
+
+func (p *Platform) GetBlockByNumberWithContext(ctx context.Context, num int64) (*blockatlas.Block, error) {
+	if srcBlock, err := p.client.GetBlockByNumber(ctx, num); err == nil {
+		block := p.NormalizeBlock(ctx, srcBlock)
 		return &block, nil
 	} else {
 		return nil, err
 	}
 }
-func (p *Platform) NormalizeBlock(block *Block) blockatlas.Block {
+func (p *Platform) NormalizeBlock(ctx context.Context, block *Block) blockatlas.Block {
 	return blockatlas.Block{
 		ID:     block.Ledger.Id,
 		Number: block.Ledger.Sequence,
-		Txs:    p.NormalizePayments(block.Payments),
+		Txs:    p.NormalizePayments(ctx, block.Payments),
 	}
 }
@@ -0,0 +1,45 @@
+package stellar
+
+import (
+	"context"
+	"github.com/trustwallet/blockatlas/pkg/blockatlas"
+	"strings"
+)
+
+// BroadcastTransaction submits the base64 encoded transaction envelope
+func (p *Platform) BroadcastTransaction(raw string) (string, error) {
+	return p.BroadcastTransactionWithContext(context.Background(), raw)
+}
+
+func (p *Platform) BroadcastTransactionWithContext(ctx context.Context, raw string) (string, error) {
+	res, err := p.client.SubmitTransaction(ctx, raw)
+	if err != nil {
+		return "", err
+	}
+	return NormalizeSubmitResult(res)
+}
+
+func NormalizeSubmitResult(res SubmitResult) (string, error) {
+	if res.Hash != "" {
+		return res.Hash, nil
+	}
+	codes := res.Extras.ResultCodes
+	message := strings.Join(append([]string{codes.Transaction}, codes.Operations...), ", ")
+	if codes.Transaction == "" {
+		message = res.Title + ": " + res.Detail
+	}
+	switch codes.Transaction {
+	case "tx_bad_seq":
+		return "", &blockatlas.BroadcastError{Err: blockatlas.ErrNonceTooLow, Message: message}
+	case "tx_bad_auth", "tx_bad_auth_extra":
+		return "", &blockatlas.BroadcastError{Err: blockatlas.ErrInvalidSignature, Message: message}
+	case "tx_insufficient_balance", "tx_insufficient_fee":
+		return "", &blockatlas.BroadcastError{Err: blockatlas.ErrInsufficientFunds, Message: message}
+	}
+	for _, op := range codes.Operations {
+		if op == "op_underfunded" || op == "op_low_reserve" {
+			return "", &blockatlas.BroadcastError{Err: blockatlas.ErrInsufficientFunds, Message: message}
+		}
+	}
+	return "", &blockatlas.BroadcastError{Err: blockatlas.ErrInvalidTx, Message: message}
+}
@@ -0,0 +1,51 @@
+package stellar
+
+import (
+	"encoding/json"
+	"github.com/stretchr/testify/assert"
+	"github.com/trustwallet/blockatlas/pkg/blockatlas"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+const submitFailed = `{
+	"type": "https://stellar.org/horizon-errors/transaction_failed",
+	"title": "Transaction Failed",
+	"status": 400,
+	"extras": {
+		"envelope_xdr": "AAAAAA==",
+		"result_codes": {"transaction": "tx_failed", "operations": ["op_underfunded"]}
+	}
+}`
+
+func TestNormalizeSubmitResult(t *testing.T) {
+	hash, err := NormalizeSubmitResult(SubmitResult{Hash: "3389e9f0f1a65f19736cacf544c2e825313e8447f569233bb8db39aa607c8889"})
+	assert.Nil(t, err)
+	assert.Equal(t, "3389e9f0f1a65f19736cacf544c2e825313e8447f569233bb8db39aa607c8889", hash)
+
+	var res SubmitResult
+	assert.Nil(t, json.Unmarshal([]byte(submitFailed), &res))
+	_, err = NormalizeSubmitResult(res)
+	assert.Equal(t, blockatlas.ErrInsufficientFunds, err.(*blockatlas.BroadcastError).Err)
+	assert.Contains(t, err.Error(), "op_underfunded")
+
+	res = SubmitResult{}
+	res.Extras.ResultCodes.Transaction = "tx_bad_seq"
+	_, err = NormalizeSubmitResult(res)
+	assert.Equal(t, blockatlas.ErrNonceTooLow, err.(*blockatlas.BroadcastError).Err)
+}
+
+func TestPlatform_BroadcastTransaction(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = w.Write([]byte(`{"title":"Transaction Failed","status":400,"detail":"The transaction failed when submitted to the stellar network.","extras":{"result_codes":{"transaction":"tx_bad_seq"}}}`))
+	}))
+	defer server.Close()
+
+	p := Platform{client: Client{blockatlas.InitClient(server.URL)}}
+	_, err := p.BroadcastTransaction("AAAA")
+	if assert.IsType(t, &blockatlas.BroadcastError{}, err) {
+		assert.Equal(t, blockatlas.ErrNonceTooLow, err.(*blockatlas.BroadcastError).Err)
+	}
+}
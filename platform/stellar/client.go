@@ -1,17 +1,19 @@
 package stellar
 
 import (
+	"context"
 	"fmt"
 	"github.com/trustwallet/blockatlas/pkg/blockatlas"
 	"github.com/trustwallet/blockatlas/pkg/errors"
 	"net/url"
+	"strings"
 )
 
 type Client struct {
 	blockatlas.Request
 }
 
-func (c *Client) GetTxsOfAddress(address string) ([]Payment, error) {
+func (c *Client) GetTxsOfAddress(ctx context.Context, address string) ([]Payment, error) {
 	query := url.Values{
 		"order": {"desc"},
 		"limit": {"25"},
@@ -19,31 +21,31 @@ func (c *Client) GetTxsOfAddress(address string) ([]Payment, error) {
 	path := fmt.Sprintf("accounts/%s/payments", url.PathEscape(address))
 
 	var payments PaymentsPage
-	err := c.Get(&payments, path, query)
+	err := c.GetWithContext(ctx, &payments, path, query)
 	if err != nil {
 		return nil, err
 	}
 	return payments.Embedded.Records, nil
 }
 
-func (c *Client) GetTxHash(id string) (TxHash, error) {
+func (c *Client) GetTxHash(ctx context.Context, id string) (TxHash, error) {
 	path := fmt.Sprintf("transactions/%s", id)
 
 	var hash TxHash
-	err := c.Get(&hash, path, nil)
+	err := c.GetWithContext(ctx, &hash, path, nil)
 	if err != nil {
 		return hash, err
 	}
 	return hash, nil
 }
 
-func (c *Client) CurrentBlockNumber() (int64, error) {
+func (c *Client) CurrentBlockNumber(ctx context.Context) (int64, error) {
 	query := url.Values{
 		"order": {"desc"},
 		"limit": {"1"},
 	}
 	var ledgers LedgersPage
-	err := c.Get(&ledgers, "ledgers", query)
+	err := c.GetWithContext(ctx, &ledgers, "ledgers", query)
 	if err != nil {
 		return 0, nil
 	}
@@ -54,8 +56,8 @@ func (c *Client) CurrentBlockNumber() (int64, error) {
 	return ledgers.Embedded.Records[0].Sequence, nil
 }
 
-func (c *Client) GetBlockByNumber(num int64) (*Block, error) {
-	ledger, err := c.getLedger(num)
+func (c *Client) GetBlockByNumber(ctx context.Context, num int64) (*Block, error) {
+	ledger, err := c.getLedger(ctx, num)
 	if err != nil {
 		return nil, err
 	}
@@ -67,15 +69,30 @@ func (c *Client) GetBlockByNumber(num int64) (*Block, error) {
 	path := fmt.Sprintf("ledgers/%d/payments", num)
 
 	var payments PaymentsPage
-	err = c.Get(&payments, path, query)
+	err = c.GetWithContext(ctx, &payments, path, query)
 	if err != nil {
 		return nil, err
 	}
 	return &Block{Ledger: *ledger, Payments: payments.Embedded.Records}, nil
 }
 
-func (c *Client) getLedger(num int64) (ledger *Ledger, err error) {
+func (c *Client) getLedger(ctx context.Context, num int64) (ledger *Ledger, err error) {
 	path := fmt.Sprintf("ledgers/%d", num)
-	err = c.Get(&ledger, path, nil)
+	err = c.GetWithContext(ctx, &ledger, path, nil)
 	return
 }
+
+func (c *Client) GetAccount(ctx context.Context, address string) (account Account, err error) {
+	path := fmt.Sprintf("accounts/%s", url.PathEscape(address))
+	err = c.GetWithContext(ctx, &account, path, nil)
+	return account, err
+}
+
+func (c *Client) SubmitTransaction(ctx context.Context, envelope string) (result SubmitResult, err error) {
+	form := url.Values{"tx": {envelope}}
+	req := c.Request
+	req.Headers = map[string]string{"Content-Type": "application/x-www-form-urlencoded"}
+	req.DecodeErrors = true
+	err = req.ExecuteWithContext(ctx, "POST", req.GetBase("transactions"), strings.NewReader(form.Encode()), &result)
+	return result, err
+}
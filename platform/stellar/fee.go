@@ -0,0 +1,17 @@
+package stellar
+
+import (
+	"context"
+	"github.com/trustwallet/blockatlas/pkg/blockatlas"
+)
+
+// baseFee is the minimum fee of a single operation transaction in stroops
+const baseFee = "100"
+
+func (p *Platform) GetFee(req blockatlas.FeeRequest) (*blockatlas.Fee, error) {
+	return p.GetFeeWithContext(context.Background(), req)
+}
+
+func (p *Platform) GetFeeWithContext(ctx context.Context, req blockatlas.FeeRequest) (*blockatlas.Fee, error) {
+	return blockatlas.StaticFee(p.CoinIndex, blockatlas.FeeUnitTx, baseFee), nil
+}
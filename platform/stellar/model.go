@@ -61,3 +61,25 @@ type Tuple struct {
 	Payment
 	TxHash
 }
+
+type Account struct {
+	Balances []Balance `json:"balances"`
+}
+
+type Balance struct {
+	Balance   string `json:"balance"`
+	AssetType string `json:"asset_type"`
+}
+
+// SubmitResult is either the accepted transaction or the Horizon problem
+type SubmitResult struct {
+	Hash   string `json:"hash"`
+	Title  string `json:"title"`
+	Detail string `json:"detail"`
+	Extras struct {
+		ResultCodes struct {
+			Transaction string   `json:"transaction"`
+			Operations  []string `json:"operations"`
+		} `json:"result_codes"`
+	} `json:"extras"`
+}
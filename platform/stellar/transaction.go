@@ -1,6 +1,7 @@
 package stellar
 
 import (
+	"context"
 	"github.com/trustwallet/blockatlas/coin"
 	"github.com/trustwallet/blockatlas/pkg/blockatlas"
 	"github.com/trustwallet/blockatlas/pkg/numbers"
@@ -10,15 +11,19 @@ import (
 )
 
 func (p *Platform) GetTxsByAddress(address string) (blockatlas.TxPage, error) {
-	payments, err := p.client.GetTxsOfAddress(address)
+	return p.GetTxsByAddressWithContext(context.Background(), address)
+}
+
+func (p *Platform) GetTxsByAddressWithContext(ctx context.Context, address string) (blockatlas.TxPage, error) {
+	payments, err := p.client.GetTxsOfAddress(ctx, address)
 	if err != nil {
 		return nil, err
 	}
 
-	return p.NormalizePayments(payments), nil
+	return p.NormalizePayments(ctx, payments), nil
 }
 
-func (p *Platform) NormalizePayments(payments []Payment) (txs []blockatlas.Tx) {
+func (p *Platform) NormalizePayments(ctx context.Context, payments []Payment) (txs []blockatlas.Tx) {
 	var (
 		wg      sync.WaitGroup
 		txsChan = make(chan blockatlas.Tx, len(payments))
@@ -29,7 +34,7 @@ func (p *Platform) NormalizePayments(payments []Payment) (txs []blockatlas.Tx) {
 		go func(pay Payment) {
 			defer wg.Done()
 
-			txHash, err := p.client.GetTxHash(pay.TransactionHash)
+			txHash, err := p.client.GetTxHash(ctx, pay.TransactionHash)
 			if err != nil {
 				return
 			}
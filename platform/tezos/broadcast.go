@@ -0,0 +1,33 @@
+package tezos
+
+import (
+	"encoding/json"
+	"github.com/trustwallet/blockatlas/pkg/blockatlas"
+	"github.com/trustwallet/blockatlas/pkg/errors"
+	"strings"
+)
+
+// BroadcastTransaction injects the hex encoded signed operation
+func (p *Platform) BroadcastTransaction(raw string) (string, error) {
+	res, err := p.rpcClient.InjectOperation(raw)
+	if err != nil {
+		return "", err
+	}
+	return NormalizeInjectionResult(res)
+}
+
+func NormalizeInjectionResult(res json.RawMessage) (string, error) {
+	var hash string
+	if err := json.Unmarshal(res, &hash); err == nil {
+		return hash, nil
+	}
+	var rpcErrors []RpcError
+	if err := json.Unmarshal(res, &rpcErrors); err != nil || len(rpcErrors) == 0 {
+		return "", errors.E("Tezos: invalid injection result", errors.TypePlatformUnmarshal, errors.Params{"result": string(res)})
+	}
+	ids := make([]string, 0, len(rpcErrors))
+	for _, e := range rpcErrors {
+		ids = append(ids, e.ID)
+	}
+	return "", blockatlas.NewBroadcastError(strings.Join(ids, ", "))
+}
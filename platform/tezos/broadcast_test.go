@@ -0,0 +1,35 @@
+package tezos
+
+import (
+	"github.com/stretchr/testify/assert"
+	"github.com/trustwallet/blockatlas/pkg/blockatlas"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestNormalizeInjectionResult(t *testing.T) {
+	hash, err := NormalizeInjectionResult([]byte(`"ooWTTc6GzUV4JxGUh8oRiN9xW8RwwcNU8DdvkhZvM34qBM1Yx2u"`))
+	assert.Nil(t, err)
+	assert.Equal(t, "ooWTTc6GzUV4JxGUh8oRiN9xW8RwwcNU8DdvkhZvM34qBM1Yx2u", hash)
+
+	_, err = NormalizeInjectionResult([]byte(`[{"kind":"temporary","id":"proto.006-PsCARTHA.contract.counter_in_the_past","contract":"tz1","expected":"12","found":"11"}]`))
+	assert.Equal(t, blockatlas.ErrNonceTooLow, err.(*blockatlas.BroadcastError).Err)
+
+	_, err = NormalizeInjectionResult([]byte(`{}`))
+	assert.NotNil(t, err)
+}
+
+func TestPlatform_BroadcastTransaction(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+		_, _ = w.Write([]byte(`[{"kind":"temporary","id":"proto.006-PsCARTHA.contract.counter_in_the_past","contract":"tz1","expected":"12","found":"11"}]`))
+	}))
+	defer server.Close()
+
+	p := Platform{rpcClient: RpcClient{blockatlas.InitClient(server.URL)}}
+	_, err := p.BroadcastTransaction("deadbeef")
+	if assert.IsType(t, &blockatlas.BroadcastError{}, err) {
+		assert.Equal(t, blockatlas.ErrNonceTooLow, err.(*blockatlas.BroadcastError).Err)
+	}
+}
@@ -92,3 +92,9 @@ type Account struct {
 	Balance  string `json:"balance"`
 	Delegate string `json:"delegate"`
 }
+
+type RpcError struct {
+	Kind string `json:"kind"`
+	ID   string `json:"id"`
+	Msg  string `json:"msg,omitempty"`
+}
@@ -1,6 +1,8 @@
 package tezos
 
 import (
+	"context"
+	"encoding/json"
 	"fmt"
 	"github.com/trustwallet/blockatlas/pkg/blockatlas"
 )
@@ -15,17 +17,27 @@ const (
 	TestingPeriodType PeriodType = "testing"
 )
 
-func (c *RpcClient) GetValidators(blockID string) (validators []Validator, err error) {
-	err = c.Get(&validators, fmt.Sprintf("chains/main/blocks/%s/votes/listings", blockID), nil)
+func (c *RpcClient) GetValidators(ctx context.Context, blockID string) (validators []Validator, err error) {
+	err = c.GetWithContext(ctx, &validators, fmt.Sprintf("chains/main/blocks/%s/votes/listings", blockID), nil)
 	return
 }
 
-func (c *RpcClient) GetPeriodType() (periodType PeriodType, err error) {
-	err = c.Get(&periodType, "chains/main/blocks/head/votes/current_period_kind", nil)
+func (c *RpcClient) GetPeriodType(ctx context.Context) (periodType PeriodType, err error) {
+	err = c.GetWithContext(ctx, &periodType, "chains/main/blocks/head/votes/current_period_kind", nil)
 	return
 }
 
-func (c *RpcClient) GetAccount(address string) (account Account, err error) {
-	err = c.Get(&account, "chains/main/blocks/head/context/contracts/"+address, nil)
+func (c *RpcClient) GetAccount(ctx context.Context, address string) (account Account, err error) {
+	err = c.GetWithContext(ctx, &account, "chains/main/blocks/head/context/contracts/"+address, nil)
+	return
+}
+
+// InjectOperation injects the signed operation bytes, the node responds with the operation hash
+// or with the list of errors
+// InjectOperation injects the signed operation, the node rejects it with a list of errors
+func (c *RpcClient) InjectOperation(raw string) (result json.RawMessage, err error) {
+	req := c.Request
+	req.DecodeErrors = true
+	err = req.Post(&result, "injection/operation", raw)
 	return
 }
@@ -1,6 +1,7 @@
 package tezos
 
 import (
+	"context"
 	"github.com/trustwallet/blockatlas/pkg/blockatlas"
 	"github.com/trustwallet/blockatlas/pkg/errors"
 	services "github.com/trustwallet/blockatlas/services/assets"
@@ -9,7 +10,11 @@ import (
 const Annual = 6.09
 
 func (p *Platform) GetDelegations(address string) (blockatlas.DelegationsPage, error) {
-	account, err := p.rpcClient.GetAccount(address)
+	return p.GetDelegationsWithContext(context.Background(), address)
+}
+
+func (p *Platform) GetDelegationsWithContext(ctx context.Context, address string) (blockatlas.DelegationsPage, error) {
+	account, err := p.rpcClient.GetAccount(ctx, address)
 	if err != nil {
 		return nil, err
 	}
@@ -17,7 +22,7 @@ func (p *Platform) GetDelegations(address string) (blockatlas.DelegationsPage, e
 		return make(blockatlas.DelegationsPage, 0), nil
 	}
 
-	validators, err := services.GetValidatorsMap(p)
+	validators, err := services.GetValidatorsMap(ctx, p)
 	if err != nil {
 		return nil, err
 	}
@@ -40,9 +45,13 @@ func NormalizeDelegation(account Account, validators blockatlas.ValidatorMap) (b
 }
 
 func (p *Platform) GetValidators() (blockatlas.ValidatorPage, error) {
+	return p.GetValidatorsWithContext(context.Background())
+}
+
+func (p *Platform) GetValidatorsWithContext(ctx context.Context) (blockatlas.ValidatorPage, error) {
 	results := make(blockatlas.ValidatorPage, 0)
 
-	validators, err := p.getCurrentValidators()
+	validators, err := p.getCurrentValidators(ctx)
 	if err != nil {
 		return results, err
 	}
@@ -53,17 +62,17 @@ func (p *Platform) GetValidators() (blockatlas.ValidatorPage, error) {
 	return results, nil
 }
 
-func (p *Platform) getCurrentValidators() (validators []Validator, err error) {
-	periodType, err := p.rpcClient.GetPeriodType()
+func (p *Platform) getCurrentValidators(ctx context.Context) (validators []Validator, err error) {
+	periodType, err := p.rpcClient.GetPeriodType(ctx)
 	if err != nil {
 		return validators, err
 	}
 
 	switch periodType {
 	case TestingPeriodType:
-		return p.rpcClient.GetValidators("head~32768")
+		return p.rpcClient.GetValidators(ctx, "head~32768")
 	default:
-		return p.rpcClient.GetValidators("head")
+		return p.rpcClient.GetValidators(ctx, "head")
 	}
 }
 
@@ -72,7 +81,11 @@ func (p *Platform) GetDetails() blockatlas.StakingDetails {
 }
 
 func (p *Platform) UndelegatedBalance(address string) (string, error) {
-	account, err := p.rpcClient.GetAccount(address)
+	return p.UndelegatedBalanceWithContext(context.Background(), address)
+}
+
+func (p *Platform) UndelegatedBalanceWithContext(ctx context.Context, address string) (string, error) {
+	account, err := p.rpcClient.GetAccount(ctx, address)
 	if err != nil {
 		return "0", err
 	}
@@ -0,0 +1,53 @@
+package tron
+
+import (
+	"context"
+	"github.com/trustwallet/blockatlas/pkg/blockatlas"
+	"strconv"
+)
+
+func (p *Platform) GetBalance(address string) (*blockatlas.Balance, error) {
+	return p.GetBalanceWithContext(context.Background(), address)
+}
+
+func (p *Platform) GetBalanceWithContext(ctx context.Context, address string) (*blockatlas.Balance, error) {
+	account, err := p.client.GetAccount(ctx, address)
+	if err != nil {
+		return nil, err
+	}
+	balance := &blockatlas.Balance{Coin: p.Coin().ID, Address: address, Balance: "0"}
+	if len(account.Data) == 0 {
+		return balance, nil
+	}
+	data := account.Data[0]
+	balance.Balance = strconv.FormatUint(uint64(data.Balance), 10)
+
+	ids := make([]string, 0, len(data.AssetsV2))
+	for _, asset := range data.AssetsV2 {
+		ids = append(ids, asset.Key)
+	}
+	tokens := make(map[string]blockatlas.Token)
+	for token := range p.getTokens(ctx, ids) {
+		tokens[token.TokenID] = token
+	}
+	balance.Tokens = NormalizeTokenBalances(data.AssetsV2, tokens)
+	return balance, nil
+}
+
+// NormalizeTokenBalances converts the TRC10 assets, the assets without the token info are skipped
+func NormalizeTokenBalances(assets []AssetV2, tokens map[string]blockatlas.Token) []blockatlas.TokenBalance {
+	result := make([]blockatlas.TokenBalance, 0, len(assets))
+	for _, asset := range assets {
+		token, ok := tokens[asset.Key]
+		if !ok {
+			continue
+		}
+		result = append(result, blockatlas.TokenBalance{
+			TokenID:  asset.Key,
+			Symbol:   token.Symbol,
+			Decimals: token.Decimals,
+			Balance:  strconv.FormatInt(asset.Value, 10),
+		})
+	}
+	return result
+}
@@ -0,0 +1,20 @@
+package tron
+
+import (
+	"github.com/stretchr/testify/assert"
+	"github.com/trustwallet/blockatlas/pkg/blockatlas"
+	"testing"
+)
+
+func TestNormalizeTokenBalances(t *testing.T) {
+	assets := []AssetV2{
+		{Key: "1002000", Value: 1500000},
+		{Key: "1000001", Value: 7},
+	}
+	tokens := map[string]blockatlas.Token{
+		"1002000": {TokenID: "1002000", Symbol: "BTT", Decimals: 6},
+	}
+	assert.Equal(t, []blockatlas.TokenBalance{
+		{TokenID: "1002000", Symbol: "BTT", Decimals: 6, Balance: "1500000"},
+	}, NormalizeTokenBalances(assets, tokens))
+}
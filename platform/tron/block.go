@@ -1,22 +1,31 @@
 package tron
 
 import (
+	"context"
 	"encoding/hex"
 	"github.com/trustwallet/blockatlas/pkg/blockatlas"
 	"sync"
 )
 
 func (p *Platform) CurrentBlockNumber() (int64, error) {
-	return p.client.CurrentBlockNumber()
+	return p.CurrentBlockNumberWithContext(context.Background())
+}
+
+func (p *Platform) CurrentBlockNumberWithContext(ctx context.Context) (int64, error) {
+	return p.client.CurrentBlockNumber(ctx)
 }
 
 func (p *Platform) GetBlockByNumber(num int64) (*blockatlas.Block, error) {
-	block, err := p.client.GetBlockByNumber(num)
+	return p.GetBlockByNumberWithContext(context.Background(), num)
+}
+
+func (p *Platform) GetBlockByNumberWithContext(ctx context.Context, num int64) (*blockatlas.Block, error) {
+	block, err := p.client.GetBlockByNumber(ctx, num)
 	if err != nil {
 		return nil, err
 	}
 
-	txsChan := p.NormalizeBlockTxs(block.Txs)
+	txsChan := p.NormalizeBlockTxs(ctx, block.Txs)
 	txs := make(blockatlas.TxPage, 0)
 	for cTxs := range txsChan {
 		txs = append(txs, cTxs)
@@ -28,14 +37,14 @@ func (p *Platform) GetBlockByNumber(num int64) (*blockatlas.Block, error) {
 	}, nil
 }
 
-func (p *Platform) NormalizeBlockTxs(srcTxs []Tx) chan blockatlas.Tx {
+func (p *Platform) NormalizeBlockTxs(ctx context.Context, srcTxs []Tx) chan blockatlas.Tx {
 	txChan := make(chan blockatlas.Tx, len(srcTxs))
 	var wg sync.WaitGroup
 	for _, srcTx := range srcTxs {
 		wg.Add(1)
 		go func(s Tx, c chan blockatlas.Tx) {
 			defer wg.Done()
-			p.NormalizeBlockChannel(s, c)
+			p.NormalizeBlockChannel(ctx, s, c)
 		}(srcTx, txChan)
 	}
 	wg.Wait()
@@ -43,7 +52,7 @@ func (p *Platform) NormalizeBlockTxs(srcTxs []Tx) chan blockatlas.Tx {
 	return txChan
 }
 
-func (p *Platform) NormalizeBlockChannel(srcTx Tx, txChan chan blockatlas.Tx) {
+func (p *Platform) NormalizeBlockChannel(ctx context.Context, srcTx Tx, txChan chan blockatlas.Tx) {
 	if len(srcTx.Data.Contracts) == 0 {
 		return
 	}
@@ -56,7 +65,7 @@ func (p *Platform) NormalizeBlockChannel(srcTx Tx, txChan chan blockatlas.Tx) {
 	if len(transfer.AssetName) > 0 {
 		assetName, err := hex.DecodeString(transfer.AssetName[:])
 		if err == nil {
-			info, err := p.client.GetTokenInfo(string(assetName))
+			info, err := p.client.GetTokenInfo(ctx, string(assetName))
 			if err == nil && len(info.Data) > 0 {
 				setTokenMeta(tx, srcTx, info.Data[0])
 			}
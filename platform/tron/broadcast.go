@@ -0,0 +1,41 @@
+package tron
+
+import (
+	"context"
+	"encoding/hex"
+	"github.com/trustwallet/blockatlas/pkg/blockatlas"
+)
+
+// BroadcastTransaction broadcasts the hex encoded signed transaction
+func (p *Platform) BroadcastTransaction(raw string) (string, error) {
+	return p.BroadcastTransactionWithContext(context.Background(), raw)
+}
+
+func (p *Platform) BroadcastTransactionWithContext(ctx context.Context, raw string) (string, error) {
+	res, err := p.client.BroadcastHex(ctx, raw)
+	if err != nil {
+		return "", err
+	}
+	return NormalizeBroadcastResult(res)
+}
+
+func NormalizeBroadcastResult(res BroadcastResult) (string, error) {
+	if res.Result {
+		return res.TxID, nil
+	}
+	// The node returns the messages hex encoded
+	message := res.Message
+	if b, err := hex.DecodeString(message); err == nil {
+		message = string(b)
+	}
+	switch res.Code {
+	case "SIGERROR":
+		return "", &blockatlas.BroadcastError{Err: blockatlas.ErrInvalidSignature, Message: message}
+	case "DUP_TRANSACTION_ERROR":
+		return "", &blockatlas.BroadcastError{Err: blockatlas.ErrTxAlreadyKnown, Message: message}
+	case "BANDWITH_ERROR":
+		return "", &blockatlas.BroadcastError{Err: blockatlas.ErrInsufficientFunds, Message: message}
+	default:
+		return "", blockatlas.NewBroadcastError(message)
+	}
+}
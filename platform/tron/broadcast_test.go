@@ -0,0 +1,38 @@
+package tron
+
+import (
+	"github.com/stretchr/testify/assert"
+	"github.com/trustwallet/blockatlas/pkg/blockatlas"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestNormalizeBroadcastResult(t *testing.T) {
+	id, err := NormalizeBroadcastResult(BroadcastResult{Result: true, TxID: "77ddfa7093cc5f745c0d3a54abb89ef070f983343c05e0f89e5a52f3e5401299"})
+	assert.Nil(t, err)
+	assert.Equal(t, "77ddfa7093cc5f745c0d3a54abb89ef070f983343c05e0f89e5a52f3e5401299", id)
+
+	_, err = NormalizeBroadcastResult(BroadcastResult{Code: "SIGERROR", Message: "76616c6964617465207369676e6174757265206572726f72"})
+	assert.Equal(t, blockatlas.ErrInvalidSignature, err.(*blockatlas.BroadcastError).Err)
+	assert.Contains(t, err.Error(), "validate signature error")
+
+	// "Validate TransferContract error, balance is not sufficient."
+	message := "56616c6964617465205472616e73666572436f6e7472616374206572726f722c2062616c616e6365206973206e6f742073756666696369656e742e"
+	_, err = NormalizeBroadcastResult(BroadcastResult{Code: "CONTRACT_VALIDATE_ERROR", Message: message})
+	assert.Equal(t, blockatlas.ErrInsufficientFunds, err.(*blockatlas.BroadcastError).Err)
+}
+
+func TestPlatform_BroadcastTransaction(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = w.Write([]byte(`{"code":"SIGERROR","message":"76616c6964617465207369676e6174757265206572726f72"}`))
+	}))
+	defer server.Close()
+
+	p := Platform{client: Client{blockatlas.InitClient(server.URL)}}
+	_, err := p.BroadcastTransaction("deadbeef")
+	if assert.IsType(t, &blockatlas.BroadcastError{}, err) {
+		assert.Equal(t, blockatlas.ErrInvalidSignature, err.(*blockatlas.BroadcastError).Err)
+	}
+}
@@ -1,63 +1,79 @@
 package tron
 
 import (
+	"context"
 	"fmt"
 	"github.com/trustwallet/blockatlas/pkg/blockatlas"
 	"github.com/trustwallet/blockatlas/pkg/errors"
 	"net/url"
+	"strconv"
 )
 
 type Client struct {
 	blockatlas.Request
 }
 
-func (c *Client) CurrentBlockNumber() (int64, error) {
+func (c *Client) CurrentBlockNumber(ctx context.Context) (int64, error) {
 	var block Block
-	err := c.Post(&block, "wallet/getnowblock", nil)
+	err := c.PostWithContext(ctx, &block, "wallet/getnowblock", nil)
 	return block.BlockHeader.Data.Number, err
 }
 
-func (c *Client) GetBlockByNumber(num int64) (Block, error) {
+func (c *Client) GetBlockByNumber(ctx context.Context, num int64) (Block, error) {
 	var blocks Blocks
-	err := c.Post(&blocks, "wallet/getblockbylimitnext", BlockRequest{StartNum: num, EndNum: num + 1})
+	err := c.PostWithContext(ctx, &blocks, "wallet/getblockbylimitnext", BlockRequest{StartNum: num, EndNum: num + 1})
 	if err != nil || blocks.Blocks == nil || len(blocks.Blocks) == 0 {
 		return Block{}, errors.E(err, "block not found", errors.Params{"block": num})
 	}
 	return blocks.Blocks[0], nil
 }
 
-func (c *Client) GetTxsOfAddress(address, token string) ([]Tx, error) {
-	path := fmt.Sprintf("v1/accounts/%s/transactions", url.PathEscape(address))
+func (c *Client) GetTxsOfAddress(ctx context.Context, address, token string) ([]Tx, error) {
+	txs, err := c.GetTxsPage(ctx, address, token, "", 200)
+	return txs.Txs, err
+}
 
-	var txs Page
-	err := c.Get(&txs, path, url.Values{
+// GetTxsPage returns the newest transactions of the address, the page following the fingerprint if not empty
+func (c *Client) GetTxsPage(ctx context.Context, address, token, fingerprint string, limit int) (txs Page, err error) {
+	path := fmt.Sprintf("v1/accounts/%s/transactions", url.PathEscape(address))
+	query := url.Values{
 		"only_confirmed": {"true"},
-		"limit":          {"200"},
+		"limit":          {strconv.Itoa(limit)},
 		"token_id":       {token},
 		"order_by":       {"block_timestamp,desc"},
-	})
-
-	return txs.Txs, err
+	}
+	if fingerprint != "" {
+		query.Set("fingerprint", fingerprint)
+	}
+	err = c.GetWithContext(ctx, &txs, path, query)
+	return txs, err
 }
 
-func (c *Client) GetAccount(address string) (accounts *Account, err error) {
+func (c *Client) GetAccount(ctx context.Context, address string) (accounts *Account, err error) {
 	path := fmt.Sprintf("v1/accounts/%s", address)
-	err = c.Get(&accounts, path, nil)
+	err = c.GetWithContext(ctx, &accounts, path, nil)
 	return
 }
 
-func (c *Client) GetAccountVotes(address string) (account *AccountData, err error) {
-	err = c.Post(&account, "wallet/getaccount", VotesRequest{Address: address, Visible: true})
+func (c *Client) GetAccountVotes(ctx context.Context, address string) (account *AccountData, err error) {
+	err = c.PostWithContext(ctx, &account, "wallet/getaccount", VotesRequest{Address: address, Visible: true})
 	return
 }
 
-func (c *Client) GetTokenInfo(id string) (asset Asset, err error) {
+func (c *Client) GetTokenInfo(ctx context.Context, id string) (asset Asset, err error) {
 	path := fmt.Sprintf("v1/assets/%s", id)
-	err = c.Get(&asset, path, nil)
+	err = c.GetWithContext(ctx, &asset, path, nil)
+	return
+}
+
+func (c *Client) GetValidators(ctx context.Context) (validators Validators, err error) {
+	err = c.GetWithContext(ctx, &validators, "wallet/listwitnesses", nil)
 	return
 }
 
-func (c *Client) GetValidators() (validators Validators, err error) {
-	err = c.Get(&validators, "wallet/listwitnesses", nil)
+func (c *Client) BroadcastHex(ctx context.Context, raw string) (result BroadcastResult, err error) {
+	req := c.Request
+	req.DecodeErrors = true
+	err = req.PostWithContext(ctx, &result, "wallet/broadcasthex", BroadcastRequest{Transaction: raw})
 	return
 }
@@ -30,6 +30,9 @@ type Page struct {
 	Success bool   `json:"success"`
 	Error   string `json:"error,omitempty"`
 	Txs     []Tx   `json:"data"`
+	Meta    struct {
+		Fingerprint string `json:"fingerprint"`
+	} `json:"meta"`
 }
 
 type Tx struct {
@@ -78,7 +81,8 @@ type AccountData struct {
 }
 
 type AssetV2 struct {
-	Key string `json:"key"`
+	Key   string `json:"key"`
+	Value int64  `json:"value"`
 }
 
 type Votes struct {
@@ -114,3 +118,14 @@ type VotesRequest struct {
 	Address string `json:"address"`
 	Visible bool   `json:"visible"`
 }
+
+type BroadcastRequest struct {
+	Transaction string `json:"transaction"`
+}
+
+type BroadcastResult struct {
+	Result  bool   `json:"result"`
+	TxID    string `json:"txid"`
+	Code    string `json:"code"`
+	Message string `json:"message"`
+}
@@ -1,6 +1,7 @@
 package tron
 
 import (
+	"context"
 	"github.com/trustwallet/blockatlas/pkg/address"
 	"github.com/trustwallet/blockatlas/pkg/blockatlas"
 	"github.com/trustwallet/blockatlas/pkg/errors"
@@ -13,8 +14,12 @@ import (
 const Annual = 0.74
 
 func (p *Platform) GetValidators() (blockatlas.ValidatorPage, error) {
+	return p.GetValidatorsWithContext(context.Background())
+}
+
+func (p *Platform) GetValidatorsWithContext(ctx context.Context) (blockatlas.ValidatorPage, error) {
 	results := make(blockatlas.ValidatorPage, 0)
-	validators, err := p.client.GetValidators()
+	validators, err := p.client.GetValidators(ctx)
 	if err != nil {
 		return results, err
 	}
@@ -41,15 +46,19 @@ func getDetails() blockatlas.StakingDetails {
 }
 
 func (p *Platform) GetDelegations(address string) (blockatlas.DelegationsPage, error) {
+	return p.GetDelegationsWithContext(context.Background(), address)
+}
+
+func (p *Platform) GetDelegationsWithContext(ctx context.Context, address string) (blockatlas.DelegationsPage, error) {
 	results := make(blockatlas.DelegationsPage, 0)
-	votes, err := p.client.GetAccountVotes(address)
+	votes, err := p.client.GetAccountVotes(ctx, address)
 	if err != nil {
 		return nil, err
 	}
 	if len(votes.Votes) == 0 {
 		return results, nil
 	}
-	validators, err := services.GetValidatorsMap(p)
+	validators, err := services.GetValidatorsMap(ctx, p)
 	if err != nil {
 		return nil, err
 	}
@@ -58,7 +67,11 @@ func (p *Platform) GetDelegations(address string) (blockatlas.DelegationsPage, e
 }
 
 func (p *Platform) UndelegatedBalance(address string) (string, error) {
-	account, err := p.client.GetAccount(address)
+	return p.UndelegatedBalanceWithContext(context.Background(), address)
+}
+
+func (p *Platform) UndelegatedBalanceWithContext(ctx context.Context, address string) (string, error) {
+	account, err := p.client.GetAccount(ctx, address)
 	if err != nil {
 		return "0", err
 	}
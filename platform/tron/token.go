@@ -1,6 +1,7 @@
 package tron
 
 import (
+	"context"
 	"github.com/trustwallet/blockatlas/coin"
 	"github.com/trustwallet/blockatlas/pkg/blockatlas"
 	"github.com/trustwallet/blockatlas/pkg/logger"
@@ -8,7 +9,11 @@ import (
 )
 
 func (p *Platform) GetTokenListByAddress(address string) (blockatlas.TokenPage, error) {
-	tokens, err := p.client.GetAccount(address)
+	return p.GetTokenListByAddressWithContext(context.Background(), address)
+}
+
+func (p *Platform) GetTokenListByAddressWithContext(ctx context.Context, address string) (blockatlas.TokenPage, error) {
+	tokens, err := p.client.GetAccount(ctx, address)
 	if err != nil {
 		return nil, err
 	}
@@ -22,21 +27,21 @@ func (p *Platform) GetTokenListByAddress(address string) (blockatlas.TokenPage,
 		tokenIds = append(tokenIds, v.Key)
 	}
 
-	tokensChan := p.getTokens(tokenIds)
+	tokensChan := p.getTokens(ctx, tokenIds)
 	for info := range tokensChan {
 		tokenPage = append(tokenPage, info)
 	}
 	return tokenPage, nil
 }
 
-func (p *Platform) getTokens(ids []string) chan blockatlas.Token {
+func (p *Platform) getTokens(ctx context.Context, ids []string) chan blockatlas.Token {
 	tkChan := make(chan blockatlas.Token, len(ids))
 	var wg sync.WaitGroup
 	for _, id := range ids {
 		wg.Add(1)
 		go func(i string, c chan blockatlas.Token) {
 			defer wg.Done()
-			err := p.getTokensChannel(i, c)
+			err := p.getTokensChannel(ctx, i, c)
 			if err != nil {
 				logger.Error(err)
 			}
@@ -47,8 +52,8 @@ func (p *Platform) getTokens(ids []string) chan blockatlas.Token {
 	return tkChan
 }
 
-func (p *Platform) getTokensChannel(id string, tkChan chan blockatlas.Token) error {
-	info, err := p.client.GetTokenInfo(id)
+func (p *Platform) getTokensChannel(ctx context.Context, id string, tkChan chan blockatlas.Token) error {
+	info, err := p.client.GetTokenInfo(ctx, id)
 	if err != nil || len(info.Data) == 0 {
 		logger.Error(err, "GetTokenInfo: invalid token")
 		return err
@@ -1,6 +1,7 @@
 package tron
 
 import (
+	"context"
 	"github.com/trustwallet/blockatlas/coin"
 	"github.com/trustwallet/blockatlas/pkg/address"
 	"github.com/trustwallet/blockatlas/pkg/blockatlas"
@@ -9,13 +10,70 @@ import (
 )
 
 func (p *Platform) GetTxsByAddress(address string) (blockatlas.TxPage, error) {
-	Txs, err := p.client.GetTxsOfAddress(address, "")
+	return p.GetTxsByAddressWithContext(context.Background(), address)
+}
+
+func (p *Platform) GetTxsByAddressWithContext(ctx context.Context, address string) (blockatlas.TxPage, error) {
+	Txs, err := p.client.GetTxsOfAddress(ctx, address, "")
 	if err != nil && len(Txs) == 0 {
 		return nil, err
 	}
+	return normalizeTransfers(Txs), nil
+}
+
+// GetTxsByAddressFromCursor continues from the fingerprint of TronGrid kept in the cursor
+func (p *Platform) GetTxsByAddressFromCursor(ctx context.Context, address string, cursor *blockatlas.TxCursor) (blockatlas.TxCursorPage, error) {
+	srcPage, err := p.client.GetTxsPage(ctx, address, "", fingerprint(cursor), blockatlas.TxPerPage)
+	if err != nil {
+		return blockatlas.TxCursorPage{}, err
+	}
+	return blockatlas.TxCursorPage{Txs: normalizeTransfers(srcPage.Txs), NextCursor: nextCursor(srcPage)}, nil
+}
+
+func (p *Platform) GetTokenTxsByAddress(address, token string) (blockatlas.TxPage, error) {
+	return p.GetTokenTxsByAddressWithContext(context.Background(), address, token)
+}
 
+func (p *Platform) GetTokenTxsByAddressWithContext(ctx context.Context, address, token string) (blockatlas.TxPage, error) {
+	tokenTxs, err := p.client.GetTxsOfAddress(ctx, address, token)
+	if err != nil {
+		return nil, errors.E(err, "TRON: failed to get token from address", errors.TypePlatformApi,
+			errors.Params{"address": address, "token": token})
+	}
+	return p.normalizeTokenTxs(ctx, address, token, tokenTxs)
+}
+
+func (p *Platform) GetTokenTxsByAddressFromCursor(ctx context.Context, address, token string, cursor *blockatlas.TxCursor) (blockatlas.TxCursorPage, error) {
+	srcPage, err := p.client.GetTxsPage(ctx, address, token, fingerprint(cursor), blockatlas.TxPerPage)
+	if err != nil {
+		return blockatlas.TxCursorPage{}, errors.E(err, "TRON: failed to get token from address", errors.TypePlatformApi,
+			errors.Params{"address": address, "token": token})
+	}
+	txs, err := p.normalizeTokenTxs(ctx, address, token, srcPage.Txs)
+	if err != nil {
+		return blockatlas.TxCursorPage{}, err
+	}
+	return blockatlas.TxCursorPage{Txs: txs, NextCursor: nextCursor(srcPage)}, nil
+}
+
+func fingerprint(cursor *blockatlas.TxCursor) string {
+	if cursor == nil {
+		return ""
+	}
+	return cursor.ID
+}
+
+// nextCursor keeps the fingerprint of the next page, TronGrid only returns it when there are older transactions
+func nextCursor(page Page) *blockatlas.TxCursor {
+	if page.Meta.Fingerprint == "" || len(page.Txs) == 0 {
+		return nil
+	}
+	return &blockatlas.TxCursor{ID: page.Meta.Fingerprint}
+}
+
+func normalizeTransfers(srcTxs []Tx) blockatlas.TxPage {
 	txs := make(blockatlas.TxPage, 0)
-	for _, srcTx := range Txs {
+	for _, srcTx := range srcTxs {
 		tx, err := Normalize(srcTx)
 		if err != nil {
 			continue
@@ -27,24 +85,17 @@ func (p *Platform) GetTxsByAddress(address string) (blockatlas.TxPage, error) {
 			continue
 		}
 	}
-
-	return txs, nil
+	return txs
 }
 
-func (p *Platform) GetTokenTxsByAddress(address, token string) (blockatlas.TxPage, error) {
-	tokenTxs, err := p.client.GetTxsOfAddress(address, token)
-	if err != nil {
-		return nil, errors.E(err, "TRON: failed to get token from address", errors.TypePlatformApi,
-			errors.Params{"address": address, "token": token})
-	}
-
+func (p *Platform) normalizeTokenTxs(ctx context.Context, address, token string, tokenTxs []Tx) (blockatlas.TxPage, error) {
 	txs := make(blockatlas.TxPage, 0)
 
 	if len(tokenTxs) == 0 {
 		return txs, nil
 	}
 
-	info, err := p.client.GetTokenInfo(token)
+	info, err := p.client.GetTokenInfo(ctx, token)
 	if err != nil || len(info.Data) == 0 {
 		return nil, errors.E(err, "TRON: failed to get token info", errors.TypePlatformApi,
 			errors.Params{"address": address, "token": token})
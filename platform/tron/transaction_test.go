@@ -1,10 +1,13 @@
 package tron
 
 import (
+	"context"
 	"encoding/json"
 	"github.com/stretchr/testify/assert"
 	"github.com/trustwallet/blockatlas/coin"
 	"github.com/trustwallet/blockatlas/pkg/blockatlas"
+	"net/http"
+	"net/http/httptest"
 	"testing"
 )
 
@@ -133,3 +136,27 @@ func testNormalize(t *testing.T, _test *test) {
 	assert.NotNil(t, res)
 	assert.Equal(t, _test.expected, res)
 }
+
+func TestPlatform_GetTxsByAddressFromCursor(t *testing.T) {
+	var fingerprint string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fingerprint = r.URL.Query().Get("fingerprint")
+		if fingerprint != "" {
+			_, _ = w.Write([]byte(`{"success":true,"data":[` + transferSrc + `],"meta":{"page_size":1}}`))
+			return
+		}
+		_, _ = w.Write([]byte(`{"success":true,"data":[` + transferSrc + `],"meta":{"fingerprint":"9xK2","page_size":1}}`))
+	}))
+	defer server.Close()
+
+	p := Init(server.URL)
+	page, err := p.GetTxsByAddressFromCursor(context.Background(), "TMuA6YqfCeX8EhbfYEg5y7S4DqzSJireY9", nil)
+	assert.Nil(t, err)
+	assert.Len(t, page.Txs, 1)
+	assert.Equal(t, &blockatlas.TxCursor{ID: "9xK2"}, page.NextCursor)
+
+	page, err = p.GetTxsByAddressFromCursor(context.Background(), "TMuA6YqfCeX8EhbfYEg5y7S4DqzSJireY9", page.NextCursor)
+	assert.Nil(t, err)
+	assert.Equal(t, "9xK2", fingerprint)
+	assert.Nil(t, page.NextCursor)
+}
@@ -0,0 +1,72 @@
+package platform
+
+import (
+	"fmt"
+	"github.com/spf13/viper"
+	"github.com/trustwallet/blockatlas/pkg/blockatlas"
+)
+
+// initUpstream applies the config of the platform clients
+func initUpstream() {
+	if viper.IsSet("upstream.timeout") {
+		blockatlas.DefaultClient.Timeout = viper.GetDuration("upstream.timeout")
+	}
+	if viper.IsSet("upstream.retries") {
+		blockatlas.UpstreamRetries = uint64(viper.GetInt("upstream.retries"))
+	}
+	if viper.IsSet("upstream.retry_interval") {
+		blockatlas.UpstreamRetryInterval = viper.GetDuration("upstream.retry_interval")
+	}
+	if viper.IsSet("upstream.probe_interval") {
+		blockatlas.EndpointProbeInterval = viper.GetDuration("upstream.probe_interval")
+	}
+	if viper.IsSet("upstream.breaker_threshold") {
+		blockatlas.BreakerThreshold = viper.GetInt("upstream.breaker_threshold")
+	}
+}
+
+// setTimeout applies the "<handle>.timeout" config to the endpoints of the platform
+func setTimeout(handle string) {
+	key := fmt.Sprintf("%s.timeout", handle)
+	if !viper.IsSet(key) {
+		return
+	}
+	timeout := viper.GetDuration(key)
+	for _, endpoints := range platformEndpoints(handle) {
+		endpoints.SetTimeout(timeout)
+	}
+}
+
+// setPlatform labels the upstream metrics of the endpoints of the platform with its handle
+func setPlatform(handle string) {
+	for _, endpoints := range platformEndpoints(handle) {
+		endpoints.SetPlatform(handle)
+	}
+}
+
+// platformEndpoints returns the endpoints used by the clients of the platform by config key
+func platformEndpoints(handle string) map[string]*blockatlas.Endpoints {
+	result := make(map[string]*blockatlas.Endpoints)
+	for key := range viper.GetStringMap(handle) {
+		endpoints := blockatlas.FindEndpoints(GetVar(fmt.Sprintf("%s.%s", handle, key)))
+		if endpoints != nil {
+			result[key] = endpoints
+		}
+	}
+	return result
+}
+
+// EndpointsStatus returns the state of the endpoints and their circuit breakers
+// of every platform by handle and config key
+func EndpointsStatus() map[string]map[string][]blockatlas.EndpointStatus {
+	result := make(map[string]map[string][]blockatlas.EndpointStatus)
+	for handle := range Platforms {
+		for key, endpoints := range platformEndpoints(handle) {
+			if result[handle] == nil {
+				result[handle] = make(map[string][]blockatlas.EndpointStatus)
+			}
+			result[handle][key] = endpoints.Status()
+		}
+	}
+	return result
+}
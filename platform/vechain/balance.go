@@ -0,0 +1,38 @@
+package vechain
+
+import (
+	"github.com/trustwallet/blockatlas/pkg/blockatlas"
+	"github.com/trustwallet/blockatlas/pkg/errors"
+	"github.com/trustwallet/blockatlas/pkg/numbers"
+)
+
+func (p *Platform) GetBalance(address string) (*blockatlas.Balance, error) {
+	acc, err := p.client.GetAccount(address)
+	if err != nil {
+		return nil, err
+	}
+	return NormalizeBalance(acc, p.Coin().ID, address)
+}
+
+// NormalizeBalance converts the VET balance and returns the gas token as a token
+func NormalizeBalance(acc Account, coinIndex uint, address string) (*blockatlas.Balance, error) {
+	balance, err := numbers.HexToDecimal(acc.Balance)
+	if err != nil {
+		return nil, errors.E("Invalid asset balance", errors.Params{"balance": acc.Balance})
+	}
+	energy, err := numbers.HexToDecimal(acc.Energy)
+	if err != nil {
+		return nil, errors.E("Invalid asset balance", errors.Params{"energy": acc.Energy})
+	}
+	return &blockatlas.Balance{
+		Coin:    coinIndex,
+		Address: address,
+		Balance: balance,
+		Tokens: []blockatlas.TokenBalance{{
+			TokenID:  gasTokenAddress,
+			Symbol:   gasTokenSymbol,
+			Decimals: gasTokenDecimals,
+			Balance:  energy,
+		}},
+	}, nil
+}
@@ -0,0 +1,20 @@
+package vechain
+
+import (
+	"github.com/stretchr/testify/assert"
+	"github.com/trustwallet/blockatlas/coin"
+	"testing"
+)
+
+func TestNormalizeBalance(t *testing.T) {
+	acc := Account{Balance: "0x1fbad5f2e25570000", Energy: "0x2b5e3af16b1880000"}
+	balance, err := NormalizeBalance(acc, coin.VET, "0xB5e883349e68aB59307d1604555AC890fAC47128")
+	assert.Nil(t, err)
+	assert.Equal(t, "36582000000000000000", balance.Balance)
+	assert.Len(t, balance.Tokens, 1)
+	assert.Equal(t, gasTokenAddress, balance.Tokens[0].TokenID)
+	assert.Equal(t, "50000000000000000000", balance.Tokens[0].Balance)
+
+	_, err = NormalizeBalance(Account{Balance: "zz"}, coin.VET, "")
+	assert.NotNil(t, err)
+}
@@ -19,8 +19,9 @@ func (p *Platform) GetBlockByNumber(num int64) (*blockatlas.Block, error) {
 		txs = append(txs, t...)
 	}
 	return &blockatlas.Block{
-		Number: num,
-		ID:     block.Id,
-		Txs:    txs,
+		Number:   num,
+		ID:       block.Id,
+		ParentID: block.ParentId,
+		Txs:      txs,
 	}, nil
 }
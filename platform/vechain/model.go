@@ -9,6 +9,7 @@ const (
 	gasTokenName     = "VeThor"
 	gasTokenSymbol   = "VTHO"
 	gasTokenDecimals = 18
+	gasTokenAddress  = "0x0000000000000000000000000000456E65726779"
 )
 
 type LogRequest struct {
@@ -40,6 +41,7 @@ type CriteriaSet struct {
 
 type Block struct {
 	Id           string   `json:"id"`
+	ParentId     string   `json:"parentID"`
 	Number       int64    `json:"number"`
 	Transactions []string `json:"transactions"`
 }
@@ -94,4 +96,5 @@ type LogMeta struct {
 
 type Account struct {
 	Balance string `json:"balance"`
+	Energy  string `json:"energy"`
 }
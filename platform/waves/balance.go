@@ -0,0 +1,18 @@
+package waves
+
+import (
+	"github.com/trustwallet/blockatlas/pkg/blockatlas"
+	"strconv"
+)
+
+func (p *Platform) GetBalance(address string) (*blockatlas.Balance, error) {
+	balance, err := p.client.GetBalance(address)
+	if err != nil {
+		return nil, err
+	}
+	return &blockatlas.Balance{
+		Coin:    p.Coin().ID,
+		Address: address,
+		Balance: strconv.FormatInt(balance.Balance, 10),
+	}, nil
+}
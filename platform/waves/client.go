@@ -3,6 +3,7 @@ package waves
 import (
 	"fmt"
 	"github.com/trustwallet/blockatlas/pkg/blockatlas"
+	"net/url"
 )
 
 type Client struct {
@@ -10,9 +11,18 @@ type Client struct {
 }
 
 func (c *Client) GetTxs(address string, limit int) ([]Transaction, error) {
+	return c.GetTxsAfter(address, limit, "")
+}
+
+// GetTxsAfter returns the transactions older than the transaction with the given id
+func (c *Client) GetTxsAfter(address string, limit int, after string) ([]Transaction, error) {
 	path := fmt.Sprintf("transactions/address/%s/limit/%d", address, limit)
+	var query url.Values
+	if after != "" {
+		query = url.Values{"after": {after}}
+	}
 	txs := make([][]Transaction, 0)
-	err := c.Get(&txs, path, nil)
+	err := c.Get(&txs, path, query)
 
 	if len(txs) > 0 {
 		return txs[0], err
@@ -34,3 +44,10 @@ func (c *Client) GetCurrentBlock() (block *CurrentBlock, err error) {
 
 	return block, err
 }
+
+func (c *Client) GetBalance(address string) (balance *Balance, err error) {
+	path := fmt.Sprintf("addresses/balance/%s", address)
+	err = c.Get(&balance, path, nil)
+
+	return balance, err
+}
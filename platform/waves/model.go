@@ -21,3 +21,8 @@ type CurrentBlock struct {
 type Block struct {
 	Transactions []Transaction `json:"transactions"`
 }
+
+type Balance struct {
+	Address string `json:"address"`
+	Balance int64  `json:"balance"`
+}
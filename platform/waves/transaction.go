@@ -1,6 +1,7 @@
 package waves
 
 import (
+	"context"
 	"github.com/trustwallet/blockatlas/pkg/blockatlas"
 	"strconv"
 
@@ -18,6 +19,26 @@ func (p *Platform) GetTxsByAddress(address string) (blockatlas.TxPage, error) {
 	return txs, nil
 }
 
+func (p *Platform) GetTxsByAddressFromCursor(ctx context.Context, address string, cursor *blockatlas.TxCursor) (blockatlas.TxCursorPage, error) {
+	var after string
+	if cursor != nil {
+		after = cursor.ID
+	}
+	srcTxs, err := p.client.GetTxsAfter(address, blockatlas.TxPerPage, after)
+	if err != nil {
+		return blockatlas.TxCursorPage{}, err
+	}
+
+	page := blockatlas.TxCursorPage{Txs: NormalizeTxs(srcTxs)}
+	// Node returns the newest transactions first, a full page means there may be older ones.
+	// The page is decided on the node transactions, NormalizeTxs drops the unsupported ones
+	if len(srcTxs) >= blockatlas.TxPerPage {
+		last := srcTxs[len(srcTxs)-1]
+		page.NextCursor = &blockatlas.TxCursor{Block: last.Block, ID: last.Id}
+	}
+	return page, nil
+}
+
 func NormalizeTxs(srcTxs []Transaction) (txs []blockatlas.Tx) {
 	for _, srcTx := range srcTxs {
 		tx, ok := NormalizeTx(&srcTx)
@@ -2,8 +2,12 @@ package waves
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"github.com/trustwallet/blockatlas/pkg/blockatlas"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
 	"testing"
 )
 
@@ -193,3 +197,41 @@ func testFilterTxs(t *testing.T, _test *txFilterTest) {
 		t.Error(_test.name + ": txs don't equal")
 	}
 }
+
+func TestPlatform_GetTxsByAddressFromCursor(t *testing.T) {
+	srcTxs := make([]Transaction, blockatlas.TxPerPage)
+	for i := range srcTxs {
+		// Only the first transaction is a supported transfer
+		srcTxs[i] = Transaction{Id: strconv.Itoa(i), Type: 11, Block: uint64(100 - i)}
+	}
+	srcTxs[0].Type = 4
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("after") != "" {
+			_ = json.NewEncoder(w).Encode([][]Transaction{{}})
+			return
+		}
+		_ = json.NewEncoder(w).Encode([][]Transaction{srcTxs})
+	}))
+	defer server.Close()
+
+	p := Init(server.URL)
+	page, err := p.GetTxsByAddressFromCursor(context.Background(), "3PLrCnhKyX5iFbGDxbqqMvea5VAqxMcinPW", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(page.Txs) != 1 {
+		t.Errorf("expected 1 transfer, got %d", len(page.Txs))
+	}
+	last := srcTxs[len(srcTxs)-1]
+	if page.NextCursor == nil || page.NextCursor.ID != last.Id || page.NextCursor.Block != last.Block {
+		t.Errorf("expected the cursor of the last node transaction, got %v", page.NextCursor)
+	}
+
+	page, err = p.GetTxsByAddressFromCursor(context.Background(), "3PLrCnhKyX5iFbGDxbqqMvea5VAqxMcinPW", page.NextCursor)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(page.Txs) != 0 || page.NextCursor != nil {
+		t.Errorf("expected the last page, got %d txs and cursor %v", len(page.Txs), page.NextCursor)
+	}
+}
@@ -0,0 +1,21 @@
+package zilliqa
+
+import (
+	"github.com/trustwallet/blockatlas/pkg/blockatlas"
+)
+
+func (p *Platform) GetBalance(address string) (*blockatlas.Balance, error) {
+	keyHash, err := DecodeAddressToKeyHash(address)
+	if err != nil {
+		return nil, blockatlas.ErrInvalidAddr
+	}
+	balance, err := p.rpcClient.GetBalance(keyHash)
+	if err != nil {
+		return nil, err
+	}
+	return &blockatlas.Balance{
+		Coin:    p.Coin().ID,
+		Address: address,
+		Balance: balance.Balance,
+	}, nil
+}
@@ -6,6 +6,7 @@ import (
 	"strings"
 
 	"github.com/btcsuite/btcutil/bech32"
+	"github.com/trustwallet/blockatlas/pkg/blockatlas"
 )
 
 const HRP string = "zil"
@@ -31,3 +32,19 @@ func EncodeKeyHashToAddress(keyHash []byte) string {
 	}
 	return encoded
 }
+
+// DecodeAddressToKeyHash converts a bech32 address to the hex key hash used by the RPC API
+func DecodeAddressToKeyHash(address string) (string, error) {
+	hrp, data, err := bech32.Decode(address)
+	if err != nil {
+		return "", err
+	}
+	if hrp != HRP {
+		return "", blockatlas.ErrInvalidAddr
+	}
+	conv, err := bech32.ConvertBits(data, 5, 8, false)
+	if err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(conv), nil
+}
@@ -57,3 +57,17 @@ func Test_EncodeKeyHashToAddress(t *testing.T) {
 		})
 	}
 }
+
+func Test_DecodeAddressToKeyHash(t *testing.T) {
+	got, err := DecodeAddressToKeyHash("zil10lx2eurx5hexaca0lshdr75czr025cevqu83uz")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := "7fccacf066a5f26ee3affc2ed1fa9810deaa632c"; got != want {
+		t.Errorf("DecodeAddressToKeyHash() = %v, want %v", got, want)
+	}
+
+	if _, err := DecodeAddressToKeyHash("bc1qar0srrr7xfkvy5l643lydnw9re59gtzzwf5mdq"); err == nil {
+		t.Error("DecodeAddressToKeyHash() expected an error for a non zil address")
+	}
+}
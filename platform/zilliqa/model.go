@@ -95,3 +95,8 @@ type BlockTxRpc struct {
 	Result  BlockTxs             `json:"result,omitempty"`
 	Id      string               `json:"id,omitempty"`
 }
+
+type BalanceRPC struct {
+	Balance string `json:"balance"`
+	Nonce   int64  `json:"nonce"`
+}
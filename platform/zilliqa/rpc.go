@@ -16,8 +16,36 @@ func (c *RpcClient) GetBlockchainInfo() (info *ChainInfo, err error) {
 	return
 }
 
+// rpcErrTxNotFound is returned by GetTransaction for unknown or not yet confirmed transactions
+const rpcErrTxNotFound = -5
+
 func (c *RpcClient) GetTx(hash string) (tx TxRPC, err error) {
-	err = c.RpcCall(&tx, "GetTransaction", []string{hash})
+	req := &blockatlas.RpcRequest{
+		JsonRpc: blockatlas.JsonRpcVersion,
+		Method:  "GetTransaction",
+		Params:  []string{hash},
+		Id:      "GetTransaction",
+	}
+	var resp *blockatlas.RpcResponse
+	err = c.Post(&resp, "", req)
+	if err != nil {
+		return
+	}
+	if resp.Error != nil {
+		if resp.Error.Code == rpcErrTxNotFound {
+			return tx, blockatlas.ErrNotFound
+		}
+		return tx, errors.E("RPC Call error", errors.Params{
+			"method":        "GetTransaction",
+			"error_code":    resp.Error.Code,
+			"error_message": resp.Error.Message})
+	}
+	err = resp.GetObject(&tx)
+	return
+}
+
+func (c *RpcClient) GetBalance(keyHash string) (balance BalanceRPC, err error) {
+	err = c.RpcCall(&balance, "GetBalance", []string{keyHash})
 	return
 }
 
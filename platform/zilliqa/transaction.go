@@ -3,6 +3,7 @@ package zilliqa
 import (
 	"github.com/trustwallet/blockatlas/coin"
 	"github.com/trustwallet/blockatlas/pkg/blockatlas"
+	"strings"
 )
 
 func (p *Platform) GetTxsByAddress(address string) (blockatlas.TxPage, error) {
@@ -24,6 +25,16 @@ func (p *Platform) GetTxsByAddress(address string) (blockatlas.TxPage, error) {
 	return normalized, nil
 }
 
+func (p *Platform) GetTxByHash(hash string) (*blockatlas.Tx, error) {
+	srcTx, err := p.rpcClient.GetTx(strings.TrimPrefix(hash, "0x"))
+	if err != nil {
+		return nil, err
+	}
+	rpcTx := srcTx.toTx()
+	tx := Normalize(&rpcTx)
+	return &tx, nil
+}
+
 func Normalize(srcTx *Tx) (tx blockatlas.Tx) {
 	tx = blockatlas.Tx{
 		ID:       srcTx.Hash,
@@ -40,6 +51,7 @@ func Normalize(srcTx *Tx) (tx blockatlas.Tx) {
 			Decimals: coin.Coins[coin.ZIL].Decimals,
 		},
 	}
+	tx.Status = blockatlas.StatusCompleted
 	if !srcTx.ReceiptSuccess {
 		tx.Status = blockatlas.StatusError
 	}
@@ -1,6 +1,7 @@
 package assets
 
 import (
+	"context"
 	"github.com/trustwallet/blockatlas/coin"
 	"github.com/trustwallet/blockatlas/pkg/blockatlas"
 	"github.com/trustwallet/blockatlas/pkg/errors"
@@ -22,8 +23,8 @@ func requestValidatorsInfo(coin coin.Coin) (AssetValidators, error) {
 	return results, nil
 }
 
-func GetValidatorsMap(api blockatlas.StakeAPI) (blockatlas.ValidatorMap, error) {
-	assets, validators, err := GetValidators(api)
+func GetValidatorsMap(ctx context.Context, api blockatlas.StakeAPI) (blockatlas.ValidatorMap, error) {
+	assets, validators, err := GetValidators(ctx, api)
 	if err != nil {
 		return nil, err
 	}
@@ -31,8 +32,8 @@ func GetValidatorsMap(api blockatlas.StakeAPI) (blockatlas.ValidatorMap, error)
 	return results.ToMap(), nil
 }
 
-func GetActiveValidators(api blockatlas.StakeAPI) (blockatlas.StakeValidators, error) {
-	assets, validators, err := GetValidators(api)
+func GetActiveValidators(ctx context.Context, api blockatlas.StakeAPI) (blockatlas.StakeValidators, error) {
+	assets, validators, err := GetValidators(ctx, api)
 	if err != nil {
 		return nil, err
 	}
@@ -40,13 +41,13 @@ func GetActiveValidators(api blockatlas.StakeAPI) (blockatlas.StakeValidators, e
 	return results, nil
 }
 
-func GetValidators(api blockatlas.StakeAPI) (AssetValidators, blockatlas.ValidatorPage, error) {
+func GetValidators(ctx context.Context, api blockatlas.StakeAPI) (AssetValidators, blockatlas.ValidatorPage, error) {
 	assetsValidators, err := requestValidatorsInfo(api.Coin())
 	if err != nil {
 		return nil, nil, errors.E(err, "unable to fetch validators list from the registry")
 	}
 
-	validators, err := api.GetValidators()
+	validators, err := blockatlas.StakeAPIContext(api).GetValidatorsWithContext(ctx)
 	if err != nil {
 		return nil, nil, err
 	}
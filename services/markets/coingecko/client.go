@@ -0,0 +1,74 @@
+package coingecko
+
+import (
+	"fmt"
+	"github.com/trustwallet/blockatlas/pkg/blockatlas"
+	"github.com/trustwallet/blockatlas/pkg/errors"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	vsCurrency    = "usd"
+	coinListCache = time.Hour
+)
+
+type Client struct {
+	blockatlas.Request
+}
+
+func (c *Client) FetchCoinList() (coins []CoinListItem, err error) {
+	query := url.Values{"include_platform": {"true"}}
+	err = c.GetWithCache(&coins, "coins/list", query, coinListCache)
+	return
+}
+
+func (c *Client) FetchMarkets(ids []string) (markets []CoinMarket, err error) {
+	query := url.Values{
+		"vs_currency": {vsCurrency},
+		"ids":         {strings.Join(ids, ",")},
+		"per_page":    {strconv.Itoa(len(ids))},
+	}
+	err = c.Get(&markets, "coins/markets", query)
+	return
+}
+
+func (c *Client) FetchRates() (rates ExchangeRates, err error) {
+	err = c.Get(&rates, "exchange_rates", nil)
+	return
+}
+
+func (c *Client) FetchMarketChart(id string, from, to int64) (chart MarketChart, err error) {
+	query := url.Values{
+		"vs_currency": {vsCurrency},
+		"from":        {strconv.FormatInt(from, 10)},
+		"to":          {strconv.FormatInt(to, 10)},
+	}
+	err = c.Get(&chart, fmt.Sprintf("coins/%s/market_chart/range", id), query)
+	return
+}
+
+func (c *Client) FetchCoin(id string) (coin Coin, err error) {
+	query := url.Values{
+		"localization":   {"false"},
+		"tickers":        {"false"},
+		"community_data": {"false"},
+		"developer_data": {"false"},
+	}
+	err = c.Get(&coin, fmt.Sprintf("coins/%s", id), query)
+	return
+}
+
+func getHTTPError(res *http.Response, desc string) error {
+	switch res.StatusCode {
+	case http.StatusOK:
+		return nil
+	case http.StatusNotFound:
+		return blockatlas.ErrNotFound
+	default:
+		return errors.E("coingecko request failed", errors.Params{"status": res.Status, "url": desc})
+	}
+}
@@ -0,0 +1,243 @@
+package coingecko
+
+import (
+	"fmt"
+	"github.com/trustwallet/blockatlas/pkg/blockatlas"
+	"github.com/trustwallet/blockatlas/pkg/errors"
+	"github.com/trustwallet/blockatlas/services/markets"
+	"strings"
+	"time"
+)
+
+const (
+	id = markets.ProviderCoingecko
+	// The number of coin ids requested at once from the markets endpoint
+	marketsBatchSize = 250
+)
+
+type asset struct {
+	coin  uint
+	token string
+}
+
+type Provider struct {
+	client Client
+}
+
+func InitProvider(api string) *Provider {
+	p := &Provider{client: Client{blockatlas.InitClient(api)}}
+	p.client.ErrorHandler = getHTTPError
+	return p
+}
+
+func (p *Provider) GetId() string {
+	return id
+}
+
+func (p *Provider) GetTickers() (blockatlas.Tickers, error) {
+	assets, err := p.getAssets()
+	if err != nil {
+		return nil, err
+	}
+	ids := make([]string, 0, len(assets))
+	for coinId := range assets {
+		ids = append(ids, coinId)
+	}
+
+	tickers := make(blockatlas.Tickers, 0, len(ids))
+	for i := 0; i < len(ids); i += marketsBatchSize {
+		end := i + marketsBatchSize
+		if end > len(ids) {
+			end = len(ids)
+		}
+		markets, err := p.client.FetchMarkets(ids[i:end])
+		if err != nil {
+			return nil, errors.E(err, "cannot fetch markets", errors.Params{"provider": id})
+		}
+		for _, m := range markets {
+			for _, a := range assets[m.Id] {
+				tickers = append(tickers, normalizeTicker(m, a))
+			}
+		}
+	}
+	return tickers, nil
+}
+
+func (p *Provider) GetRates() (blockatlas.Rates, error) {
+	rates, err := p.client.FetchRates()
+	if err != nil {
+		return nil, errors.E(err, "cannot fetch rates", errors.Params{"provider": id})
+	}
+	return normalizeRates(rates)
+}
+
+func (p *Provider) GetChartData(coin uint, token string, timeStart int64) (blockatlas.ChartData, error) {
+	coinId, err := p.getCoinId(coin, token)
+	if err != nil {
+		return blockatlas.ChartData{}, err
+	}
+	chart, err := p.client.FetchMarketChart(coinId, timeStart, time.Now().Unix())
+	if err != nil {
+		return blockatlas.ChartData{}, errors.E(err, "cannot fetch chart", errors.Params{"provider": id, "id": coinId})
+	}
+	return normalizeChart(chart), nil
+}
+
+func (p *Provider) GetCoinInfo(coin uint, token string) (blockatlas.ChartCoinInfo, error) {
+	coinId, err := p.getCoinId(coin, token)
+	if err != nil {
+		return blockatlas.ChartCoinInfo{}, err
+	}
+	c, err := p.client.FetchCoin(coinId)
+	if err != nil {
+		return blockatlas.ChartCoinInfo{}, errors.E(err, "cannot fetch coin", errors.Params{"provider": id, "id": coinId})
+	}
+	return normalizeCoinInfo(c), nil
+}
+
+// getAssets returns the tracked coins and tokens by the CoinGecko coin id
+func (p *Provider) getAssets() (map[string][]asset, error) {
+	list, err := p.client.FetchCoinList()
+	if err != nil {
+		return nil, errors.E(err, "cannot fetch coin list", errors.Params{"provider": id})
+	}
+	assets := make(map[string][]asset)
+	for c, coinId := range coinIds {
+		assets[coinId] = append(assets[coinId], asset{coin: c})
+	}
+	for _, item := range list {
+		for platform, token := range item.Platforms {
+			c, ok := platformCoins[platform]
+			if !ok || token == "" {
+				continue
+			}
+			assets[item.Id] = append(assets[item.Id], asset{coin: c, token: token})
+		}
+	}
+	return assets, nil
+}
+
+func (p *Provider) getCoinId(coin uint, token string) (string, error) {
+	if token == "" {
+		coinId, ok := coinIds[coin]
+		if !ok {
+			return "", markets.ErrUnknownAsset
+		}
+		return coinId, nil
+	}
+	assets, err := p.getAssets()
+	if err != nil {
+		return "", err
+	}
+	for coinId, list := range assets {
+		for _, a := range list {
+			if a.coin == coin && strings.EqualFold(a.token, token) {
+				return coinId, nil
+			}
+		}
+	}
+	return "", markets.ErrUnknownAsset
+}
+
+func normalizeTicker(m CoinMarket, a asset) *blockatlas.Ticker {
+	coinType := blockatlas.TypeCoin
+	if a.token != "" {
+		coinType = blockatlas.TypeToken
+	}
+	return &blockatlas.Ticker{
+		Coin:     a.coin,
+		CoinName: m.Symbol,
+		TokenId:  a.token,
+		CoinType: coinType,
+		Price: blockatlas.TickerPrice{
+			Value:     m.CurrentPrice,
+			Change24h: m.PriceChangePercentage24h,
+			Currency:  blockatlas.DefaultCurrency,
+			Provider:  id,
+		},
+		LastUpdate: m.LastUpdated,
+	}
+}
+
+// normalizeRates converts the rates, which CoinGecko gives against BTC, to the fiat rates against USD
+func normalizeRates(rates ExchangeRates) (blockatlas.Rates, error) {
+	usd, ok := rates.Rates[strings.ToLower(blockatlas.DefaultCurrency)]
+	if !ok || usd.Value == 0 {
+		return nil, errors.E("no USD rate", errors.Params{"provider": id})
+	}
+	now := time.Now().Unix()
+	result := make(blockatlas.Rates, 0, len(rates.Rates))
+	for currency, rate := range rates.Rates {
+		if rate.Type != "fiat" {
+			continue
+		}
+		result = append(result, blockatlas.Rate{
+			Currency:  strings.ToUpper(currency),
+			Rate:      rate.Value / usd.Value,
+			Timestamp: now,
+			Provider:  id,
+		})
+	}
+	return result, nil
+}
+
+func normalizeChart(chart MarketChart) blockatlas.ChartData {
+	prices := make([]blockatlas.ChartPrice, 0, len(chart.Prices))
+	for _, p := range chart.Prices {
+		if len(p) != 2 {
+			continue
+		}
+		prices = append(prices, blockatlas.ChartPrice{
+			Date:  int64(p[0]) / 1000,
+			Price: p[1],
+		})
+	}
+	return blockatlas.ChartData{Prices: prices}
+}
+
+func normalizeCoinInfo(c Coin) blockatlas.ChartCoinInfo {
+	currency := strings.ToLower(blockatlas.DefaultCurrency)
+	info := &blockatlas.CoinInfo{
+		Name:        c.Name,
+		Description: c.Description["en"],
+		DataSource:  id,
+	}
+	if len(c.Links.Homepage) > 0 {
+		info.Website = c.Links.Homepage[0]
+	}
+	if len(c.Links.ReposUrl.Github) > 0 {
+		info.SourceCode = c.Links.ReposUrl.Github[0]
+	}
+	for _, site := range c.Links.BlockchainSite {
+		if site == "" {
+			continue
+		}
+		info.Explorers = append(info.Explorers, blockatlas.Link{Name: hostName(site), Url: site})
+	}
+	if c.Links.TwitterScreenName != "" {
+		info.Socials = append(info.Socials, blockatlas.SocialLink{
+			Name:   "Twitter",
+			Url:    fmt.Sprintf("https://twitter.com/%s", c.Links.TwitterScreenName),
+			Handle: c.Links.TwitterScreenName,
+		})
+	}
+	if c.Links.SubredditUrl != "" {
+		info.Socials = append(info.Socials, blockatlas.SocialLink{
+			Name:   "Reddit",
+			Url:    c.Links.SubredditUrl,
+			Handle: strings.TrimSuffix(strings.TrimPrefix(c.Links.SubredditUrl, "https://www.reddit.com/r/"), "/"),
+		})
+	}
+	return blockatlas.ChartCoinInfo{
+		Vol24:             c.MarketData.TotalVolume[currency],
+		MarketCap:         c.MarketData.MarketCap[currency],
+		CirculatingSupply: c.MarketData.CirculatingSupply,
+		TotalSupply:       c.MarketData.TotalSupply,
+		Info:              info,
+	}
+}
+
+func hostName(link string) string {
+	host := strings.TrimPrefix(strings.TrimPrefix(link, "https://"), "http://")
+	return strings.SplitN(host, "/", 2)[0]
+}
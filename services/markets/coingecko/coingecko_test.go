@@ -0,0 +1,149 @@
+package coingecko
+
+import (
+	"github.com/stretchr/testify/assert"
+	"github.com/trustwallet/blockatlas/coin"
+	"github.com/trustwallet/blockatlas/pkg/blockatlas"
+	"github.com/trustwallet/blockatlas/services/markets"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+const (
+	coinListResponse = `[
+		{"id": "ethereum", "symbol": "eth", "name": "Ethereum", "platforms": {}},
+		{"id": "tether", "symbol": "usdt", "name": "Tether", "platforms": {"ethereum": "0xdac17f958d2ee523a2206206994597c13d831ec7", "tron": "TR7NHqjeKQxGTCi8q8ZY4pL8otSzgjLj6t"}},
+		{"id": "unknown-token", "symbol": "unk", "name": "Unknown", "platforms": {"unknown-chain": "0x1"}}
+	]`
+	marketsResponse = `[
+		{"id": "ethereum", "symbol": "eth", "name": "Ethereum", "current_price": 171.5, "price_change_percentage_24h": -1.25, "last_updated": "2020-04-01T10:00:00.000Z"},
+		{"id": "tether", "symbol": "usdt", "name": "Tether", "current_price": 1.001, "price_change_percentage_24h": 0.1, "last_updated": "2020-04-01T10:00:00.000Z"}
+	]`
+	ratesResponse = `{"rates": {
+		"btc": {"name": "Bitcoin", "unit": "BTC", "value": 1, "type": "crypto"},
+		"usd": {"name": "US Dollar", "unit": "$", "value": 6000, "type": "fiat"},
+		"eur": {"name": "Euro", "unit": "€", "value": 5400, "type": "fiat"}
+	}}`
+	chartResponse = `{"prices": [[1577871126000, 130.5], [1577874726000, 131.25]]}`
+	coinResponse  = `{
+		"id": "ethereum",
+		"name": "Ethereum",
+		"description": {"en": "Ethereum is a smart contract platform"},
+		"links": {
+			"homepage": ["https://www.ethereum.org/", ""],
+			"blockchain_site": ["https://etherscan.io/", ""],
+			"twitter_screen_name": "ethereum",
+			"subreddit_url": "https://www.reddit.com/r/ethereum",
+			"repos_url": {"github": ["https://github.com/ethereum/go-ethereum"]}
+		},
+		"market_data": {
+			"market_cap": {"usd": 19000000000, "eur": 17000000000},
+			"total_volume": {"usd": 12000000000},
+			"circulating_supply": 110000000,
+			"total_supply": 0
+		}
+	}`
+)
+
+func stubServer() *httptest.Server {
+	responses := map[string]string{
+		"/coins/list":                        coinListResponse,
+		"/coins/markets":                     marketsResponse,
+		"/exchange_rates":                    ratesResponse,
+		"/coins/ethereum/market_chart/range": chartResponse,
+		"/coins/tether/market_chart/range":   chartResponse,
+		"/coins/ethereum":                    coinResponse,
+	}
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		res, ok := responses[r.URL.Path]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		_, _ = w.Write([]byte(res))
+	}))
+}
+
+func TestProvider_GetTickers(t *testing.T) {
+	server := stubServer()
+	defer server.Close()
+	p := InitProvider(server.URL)
+
+	tickers, err := p.GetTickers()
+	assert.Nil(t, err)
+	assert.Len(t, tickers, 3)
+
+	byKey := make(map[string]*blockatlas.Ticker)
+	for _, ticker := range tickers {
+		byKey[ticker.TokenId] = ticker
+	}
+	eth := byKey[""]
+	assert.Equal(t, uint(coin.ETH), eth.Coin)
+	assert.Equal(t, blockatlas.TypeCoin, eth.CoinType)
+	assert.Equal(t, 171.5, eth.Price.Value)
+	assert.Equal(t, -1.25, eth.Price.Change24h)
+	assert.Equal(t, blockatlas.DefaultCurrency, eth.Price.Currency)
+	assert.Equal(t, markets.ProviderCoingecko, eth.Price.Provider)
+
+	usdt := byKey["TR7NHqjeKQxGTCi8q8ZY4pL8otSzgjLj6t"]
+	assert.Equal(t, uint(coin.TRX), usdt.Coin)
+	assert.Equal(t, blockatlas.TypeToken, usdt.CoinType)
+	assert.Equal(t, 1.001, usdt.Price.Value)
+}
+
+func TestProvider_GetRates(t *testing.T) {
+	server := stubServer()
+	defer server.Close()
+	p := InitProvider(server.URL)
+
+	rates, err := p.GetRates()
+	assert.Nil(t, err)
+	assert.Len(t, rates, 2)
+	for _, rate := range rates {
+		switch rate.Currency {
+		case "USD":
+			assert.Equal(t, 1.0, rate.Rate)
+		case "EUR":
+			assert.Equal(t, 0.9, rate.Rate)
+		default:
+			t.Errorf("unexpected currency %s", rate.Currency)
+		}
+	}
+}
+
+func TestProvider_GetChartData(t *testing.T) {
+	server := stubServer()
+	defer server.Close()
+	p := InitProvider(server.URL)
+
+	chart, err := p.GetChartData(coin.ETH, "0xDAC17F958D2EE523A2206206994597C13D831EC7", 1577871126)
+	assert.Nil(t, err)
+	assert.Equal(t, []blockatlas.ChartPrice{
+		{Price: 130.5, Date: 1577871126},
+		{Price: 131.25, Date: 1577874726},
+	}, chart.Prices)
+
+	_, err = p.GetChartData(coin.ETH, "0x0", 1577871126)
+	assert.Equal(t, markets.ErrUnknownAsset, err)
+}
+
+func TestProvider_GetCoinInfo(t *testing.T) {
+	server := stubServer()
+	defer server.Close()
+	p := InitProvider(server.URL)
+
+	info, err := p.GetCoinInfo(coin.ETH, "")
+	assert.Nil(t, err)
+	assert.Equal(t, 12000000000.0, info.Vol24)
+	assert.Equal(t, 19000000000.0, info.MarketCap)
+	assert.Equal(t, 110000000.0, info.CirculatingSupply)
+	assert.Equal(t, "Ethereum", info.Info.Name)
+	assert.Equal(t, "https://www.ethereum.org/", info.Info.Website)
+	assert.Equal(t, "https://github.com/ethereum/go-ethereum", info.Info.SourceCode)
+	assert.Equal(t, []blockatlas.Link{{Name: "etherscan.io", Url: "https://etherscan.io/"}}, info.Info.Explorers)
+	assert.Len(t, info.Info.Socials, 2)
+
+	_, err = p.GetCoinInfo(coin.BTC, "")
+	assert.NotNil(t, err)
+}
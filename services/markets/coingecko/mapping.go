@@ -0,0 +1,64 @@
+package coingecko
+
+import "github.com/trustwallet/blockatlas/coin"
+
+// coinIds maps the native coins to the CoinGecko coin ids
+var coinIds = map[uint]string{
+	coin.BTC:   "bitcoin",
+	coin.LTC:   "litecoin",
+	coin.DOGE:  "dogecoin",
+	coin.DASH:  "dash",
+	coin.VIA:   "viacoin",
+	coin.GRS:   "groestlcoin",
+	coin.DGB:   "digibyte",
+	coin.DCR:   "decred",
+	coin.ETH:   "ethereum",
+	coin.ETC:   "ethereum-classic",
+	coin.ICX:   "icon",
+	coin.ATOM:  "cosmos",
+	coin.ZEC:   "zcash",
+	coin.XZC:   "zcoin",
+	coin.XRP:   "ripple",
+	coin.BCH:   "bitcoin-cash",
+	coin.XLM:   "stellar",
+	coin.NANO:  "nano",
+	coin.RVN:   "ravencoin",
+	coin.POA:   "poa-network",
+	coin.TRX:   "tron",
+	coin.FIO:   "fio-protocol",
+	coin.NIM:   "nimiq-2",
+	coin.ALGO:  "algorand",
+	coin.IOTX:  "iotex",
+	coin.ZIL:   "zilliqa",
+	coin.KSM:   "kusama",
+	coin.AION:  "aion",
+	coin.AE:    "aeternity",
+	coin.KAVA:  "kava",
+	coin.THETA: "theta-token",
+	coin.BNB:   "binancecoin",
+	coin.VET:   "vechain",
+	coin.CLO:   "callisto",
+	coin.TOMO:  "tomochain",
+	coin.TT:    "thunder-token",
+	coin.ONE:   "harmony",
+	coin.ONT:   "ontology",
+	coin.XTZ:   "tezos",
+	coin.KIN:   "kin",
+	coin.NAS:   "nebulas",
+	coin.GO:    "gochain",
+	coin.QTUM:  "qtum",
+	coin.ZEL:   "zelcash",
+	coin.WAN:   "wanchain",
+	coin.WAVES: "waves",
+}
+
+// platformCoins maps the CoinGecko token platforms to the coins issuing the tokens
+var platformCoins = map[string]uint{
+	"ethereum":         coin.ETH,
+	"ethereum-classic": coin.ETC,
+	"tron":             coin.TRX,
+	"binancecoin":      coin.BNB,
+	"tomochain":        coin.TOMO,
+	"vechain":          coin.VET,
+	"waves":            coin.WAVES,
+}
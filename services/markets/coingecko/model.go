@@ -0,0 +1,61 @@
+package coingecko
+
+import "time"
+
+type (
+	CoinListItem struct {
+		Id        string            `json:"id"`
+		Symbol    string            `json:"symbol"`
+		Name      string            `json:"name"`
+		Platforms map[string]string `json:"platforms"`
+	}
+
+	CoinMarket struct {
+		Id                       string    `json:"id"`
+		Symbol                   string    `json:"symbol"`
+		Name                     string    `json:"name"`
+		CurrentPrice             float64   `json:"current_price"`
+		PriceChangePercentage24h float64   `json:"price_change_percentage_24h"`
+		LastUpdated              time.Time `json:"last_updated"`
+	}
+
+	ExchangeRates struct {
+		Rates map[string]ExchangeRate `json:"rates"`
+	}
+
+	ExchangeRate struct {
+		Name  string  `json:"name"`
+		Unit  string  `json:"unit"`
+		Value float64 `json:"value"`
+		Type  string  `json:"type"`
+	}
+
+	MarketChart struct {
+		Prices [][]float64 `json:"prices"`
+	}
+
+	Coin struct {
+		Id          string            `json:"id"`
+		Name        string            `json:"name"`
+		Description map[string]string `json:"description"`
+		Links       CoinLinks         `json:"links"`
+		MarketData  CoinMarketData    `json:"market_data"`
+	}
+
+	CoinLinks struct {
+		Homepage          []string `json:"homepage"`
+		BlockchainSite    []string `json:"blockchain_site"`
+		TwitterScreenName string   `json:"twitter_screen_name"`
+		SubredditUrl      string   `json:"subreddit_url"`
+		ReposUrl          struct {
+			Github []string `json:"github"`
+		} `json:"repos_url"`
+	}
+
+	CoinMarketData struct {
+		MarketCap         map[string]float64 `json:"market_cap"`
+		TotalVolume       map[string]float64 `json:"total_volume"`
+		CirculatingSupply float64            `json:"circulating_supply"`
+		TotalSupply       float64            `json:"total_supply"`
+	}
+)
@@ -0,0 +1,106 @@
+package markets
+
+import (
+	"errors"
+	"github.com/trustwallet/blockatlas/pkg/blockatlas"
+	"strings"
+)
+
+const ProviderCoingecko = "coingecko"
+
+// ErrUnknownCurrency signals that there is no rate for the requested currency
+var ErrUnknownCurrency = errors.New("unknown currency")
+
+// ErrUnknownAsset signals that the provider doesn't track the requested coin or token
+var ErrUnknownAsset = errors.New("unknown asset")
+
+// Provider fetches the market data from an external source,
+// prices are expected in blockatlas.DefaultCurrency and rates are units of a currency per one USD
+type Provider interface {
+	GetId() string
+	GetTickers() (blockatlas.Tickers, error)
+	GetRates() (blockatlas.Rates, error)
+	GetChartData(coin uint, token string, timeStart int64) (blockatlas.ChartData, error)
+	GetCoinInfo(coin uint, token string) (blockatlas.ChartCoinInfo, error)
+}
+
+// Storage keeps the tickers and the fiat rates fetched by the worker
+type Storage interface {
+	SaveTickers(tickers blockatlas.Tickers) error
+	GetTicker(coin uint, token string) (*blockatlas.Ticker, error)
+	SaveRates(rates blockatlas.Rates) error
+	GetRate(currency string) (*blockatlas.Rate, error)
+}
+
+type Asset struct {
+	Coin     uint                `json:"coin"`
+	CoinType blockatlas.CoinType `json:"type"`
+	TokenId  string              `json:"token_id,omitempty"`
+}
+
+// GetTickers returns the cached tickers of the assets converted to the currency,
+// assets without a ticker are skipped
+func GetTickers(s Storage, currency string, assets []Asset) (blockatlas.TickerResponse, error) {
+	currency = strings.ToUpper(currency)
+	rate, err := getRate(s, currency)
+	if err != nil {
+		return blockatlas.TickerResponse{}, err
+	}
+
+	tickers := make(blockatlas.Tickers, 0, len(assets))
+	for _, asset := range assets {
+		token := asset.TokenId
+		if asset.CoinType == blockatlas.TypeCoin {
+			token = ""
+		}
+		ticker, err := s.GetTicker(asset.Coin, token)
+		if err != nil {
+			continue
+		}
+		tickers = append(tickers, ticker)
+	}
+	tickers.ApplyRate(currency, rate.Rate, rate.PercentChange24h)
+	return blockatlas.TickerResponse{Currency: currency, Docs: tickers}, nil
+}
+
+// GetChartData returns the price history of the coin or token converted to the currency
+func GetChartData(p Provider, s Storage, coin uint, token, currency string, timeStart int64) (blockatlas.ChartData, error) {
+	rate, err := getRate(s, strings.ToUpper(currency))
+	if err != nil {
+		return blockatlas.ChartData{}, err
+	}
+	chart, err := p.GetChartData(coin, token, timeStart)
+	if err != nil {
+		return blockatlas.ChartData{}, err
+	}
+	for i := range chart.Prices {
+		chart.Prices[i].Price *= rate.Rate
+	}
+	return chart, nil
+}
+
+// GetCoinInfo returns the market capitalization and the volume of the coin or token converted to the currency
+func GetCoinInfo(p Provider, s Storage, coin uint, token, currency string) (blockatlas.ChartCoinInfo, error) {
+	rate, err := getRate(s, strings.ToUpper(currency))
+	if err != nil {
+		return blockatlas.ChartCoinInfo{}, err
+	}
+	info, err := p.GetCoinInfo(coin, token)
+	if err != nil {
+		return blockatlas.ChartCoinInfo{}, err
+	}
+	info.Vol24 *= rate.Rate
+	info.MarketCap *= rate.Rate
+	return info, nil
+}
+
+func getRate(s Storage, currency string) (*blockatlas.Rate, error) {
+	if currency == blockatlas.DefaultCurrency {
+		return &blockatlas.Rate{Currency: currency, Rate: 1}, nil
+	}
+	rate, err := s.GetRate(currency)
+	if err != nil {
+		return nil, ErrUnknownCurrency
+	}
+	return rate, nil
+}
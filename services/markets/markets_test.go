@@ -0,0 +1,137 @@
+package markets
+
+import (
+	"fmt"
+	"github.com/stretchr/testify/assert"
+	"github.com/trustwallet/blockatlas/coin"
+	"github.com/trustwallet/blockatlas/pkg/blockatlas"
+	"github.com/trustwallet/blockatlas/pkg/errors"
+	"math/big"
+	"testing"
+)
+
+type memStorage struct {
+	tickers map[string]blockatlas.Ticker
+	rates   map[string]blockatlas.Rate
+}
+
+func newMemStorage() *memStorage {
+	return &memStorage{tickers: make(map[string]blockatlas.Ticker), rates: make(map[string]blockatlas.Rate)}
+}
+
+func (s *memStorage) SaveTickers(tickers blockatlas.Tickers) error {
+	for _, t := range tickers {
+		s.tickers[fmt.Sprintf("%d-%s", t.Coin, t.TokenId)] = *t
+	}
+	return nil
+}
+
+func (s *memStorage) GetTicker(coin uint, token string) (*blockatlas.Ticker, error) {
+	t, ok := s.tickers[fmt.Sprintf("%d-%s", coin, token)]
+	if !ok {
+		return nil, errors.E("ticker not found")
+	}
+	return &t, nil
+}
+
+func (s *memStorage) SaveRates(rates blockatlas.Rates) error {
+	for _, r := range rates {
+		s.rates[r.Currency] = r
+	}
+	return nil
+}
+
+func (s *memStorage) GetRate(currency string) (*blockatlas.Rate, error) {
+	r, ok := s.rates[currency]
+	if !ok {
+		return nil, errors.E("rate not found")
+	}
+	return &r, nil
+}
+
+type stubProvider struct {
+	ratesErr error
+}
+
+func (p *stubProvider) GetId() string {
+	return "stub"
+}
+
+func (p *stubProvider) GetTickers() (blockatlas.Tickers, error) {
+	return blockatlas.Tickers{
+		{Coin: coin.ETH, CoinType: blockatlas.TypeCoin, Price: blockatlas.TickerPrice{Value: 200, Change24h: 2, Currency: blockatlas.DefaultCurrency}},
+		{Coin: coin.ETH, TokenId: "0xtoken", CoinType: blockatlas.TypeToken, Price: blockatlas.TickerPrice{Value: 1, Currency: blockatlas.DefaultCurrency}},
+	}, nil
+}
+
+func (p *stubProvider) GetRates() (blockatlas.Rates, error) {
+	if p.ratesErr != nil {
+		return nil, p.ratesErr
+	}
+	return blockatlas.Rates{{Currency: "EUR", Rate: 0.9, PercentChange24h: big.NewFloat(0.5)}}, nil
+}
+
+func (p *stubProvider) GetChartData(coin uint, token string, timeStart int64) (blockatlas.ChartData, error) {
+	return blockatlas.ChartData{Prices: []blockatlas.ChartPrice{{Price: 100, Date: timeStart}}}, nil
+}
+
+func (p *stubProvider) GetCoinInfo(coin uint, token string) (blockatlas.ChartCoinInfo, error) {
+	return blockatlas.ChartCoinInfo{Vol24: 10, MarketCap: 1000, TotalSupply: 5}, nil
+}
+
+func TestRefresh(t *testing.T) {
+	s := newMemStorage()
+	assert.Nil(t, Refresh(&stubProvider{}, s))
+	assert.Len(t, s.tickers, 2)
+	assert.Len(t, s.rates, 1)
+
+	s = newMemStorage()
+	assert.NotNil(t, Refresh(&stubProvider{ratesErr: errors.E("unavailable")}, s))
+	assert.Len(t, s.tickers, 2)
+	assert.Len(t, s.rates, 0)
+}
+
+func TestGetTickers(t *testing.T) {
+	s := newMemStorage()
+	assert.Nil(t, Refresh(&stubProvider{}, s))
+	assets := []Asset{
+		{Coin: coin.ETH, CoinType: blockatlas.TypeCoin},
+		{Coin: coin.ETH, CoinType: blockatlas.TypeToken, TokenId: "0xtoken"},
+		{Coin: coin.BTC, CoinType: blockatlas.TypeCoin},
+	}
+
+	res, err := GetTickers(s, "usd", assets)
+	assert.Nil(t, err)
+	assert.Equal(t, blockatlas.DefaultCurrency, res.Currency)
+	assert.Len(t, res.Docs, 2)
+	assert.Equal(t, 200.0, res.Docs[0].Price.Value)
+
+	res, err = GetTickers(s, "EUR", assets)
+	assert.Nil(t, err)
+	assert.Equal(t, "EUR", res.Currency)
+	assert.Equal(t, 180.0, res.Docs[0].Price.Value)
+	assert.Equal(t, 1.5, res.Docs[0].Price.Change24h)
+	assert.Equal(t, "EUR", res.Docs[1].Price.Currency)
+
+	_, err = GetTickers(s, "XXX", assets)
+	assert.Equal(t, ErrUnknownCurrency, err)
+}
+
+func TestGetChartDataAndCoinInfo(t *testing.T) {
+	s := newMemStorage()
+	p := &stubProvider{}
+	assert.Nil(t, Refresh(p, s))
+
+	chart, err := GetChartData(p, s, coin.ETH, "", "EUR", 1577871126)
+	assert.Nil(t, err)
+	assert.Equal(t, []blockatlas.ChartPrice{{Price: 90, Date: 1577871126}}, chart.Prices)
+
+	info, err := GetCoinInfo(p, s, coin.ETH, "", "EUR")
+	assert.Nil(t, err)
+	assert.Equal(t, 9.0, info.Vol24)
+	assert.Equal(t, 900.0, info.MarketCap)
+	assert.Equal(t, 5.0, info.TotalSupply)
+
+	_, err = GetCoinInfo(p, s, coin.ETH, "", "XXX")
+	assert.Equal(t, ErrUnknownCurrency, err)
+}
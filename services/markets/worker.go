@@ -0,0 +1,40 @@
+package markets
+
+import (
+	"github.com/trustwallet/blockatlas/pkg/errors"
+	"github.com/trustwallet/blockatlas/pkg/logger"
+	"time"
+)
+
+// RunWorker refreshes the cached market data once per interval, it never returns
+func RunWorker(p Provider, s Storage, interval time.Duration) {
+	logger.Info("Run market worker", logger.Params{"provider": p.GetId(), "interval": interval})
+	for {
+		if err := Refresh(p, s); err != nil {
+			logger.Error(err, "Market data refresh failed")
+		}
+		time.Sleep(interval)
+	}
+}
+
+// Refresh fetches the tickers and the rates from the provider and stores them,
+// rates are stored even if the tickers couldn't be fetched
+func Refresh(p Provider, s Storage) error {
+	params := errors.Params{"provider": p.GetId()}
+	rates, rateErr := p.GetRates()
+	if rateErr == nil {
+		rateErr = s.SaveRates(rates)
+	}
+	tickers, tickerErr := p.GetTickers()
+	if tickerErr == nil {
+		tickerErr = s.SaveTickers(tickers)
+	}
+	if rateErr != nil {
+		return errors.E(rateErr, "cannot refresh rates", params)
+	}
+	if tickerErr != nil {
+		return errors.E(tickerErr, "cannot refresh tickers", params)
+	}
+	logger.Info("Market data refreshed", logger.Params{"provider": p.GetId(), "tickers": len(tickers), "rates": len(rates)})
+	return nil
+}
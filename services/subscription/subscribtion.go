@@ -2,8 +2,12 @@ package subscription
 
 import (
 	"encoding/json"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
 	"github.com/streadway/amqp"
+	"github.com/trustwallet/blockatlas/mq"
 	"github.com/trustwallet/blockatlas/pkg/blockatlas"
+	"github.com/trustwallet/blockatlas/pkg/errors"
 	"github.com/trustwallet/blockatlas/pkg/logger"
 	"github.com/trustwallet/blockatlas/storage"
 )
@@ -12,13 +16,29 @@ const (
 	AddSubscription    blockatlas.SubscriptionOperation = "AddSubscription"
 	DeleteSubscription blockatlas.SubscriptionOperation = "DeleteSubscription"
 	UpdateSubscription blockatlas.SubscriptionOperation = "UpdateSubscription"
+
+	reasonInvalidJSON      = "invalid_json"
+	reasonUnknownOperation = "unknown_operation"
+)
+
+var (
+	// quarantine sends a message which can't be processed to the dead-letter queue
+	quarantine = mq.SubscriptionsDead.PublishError
+
+	quarantined = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "blockatlas",
+		Subsystem: "subscriber",
+		Name:      "quarantined_messages_total",
+		Help:      "Subscription messages moved to the dead-letter queue",
+	}, []string{"reason"})
 )
 
-func Consume(delivery amqp.Delivery, storage storage.Addresses) {
+func Consume(delivery amqp.Delivery, storage storage.Backend) {
 	var event blockatlas.SubscriptionEvent
 	err := json.Unmarshal(delivery.Body, &event)
 	if err != nil {
-		logger.Fatal(err)
+		reject(delivery, reasonInvalidJSON, errors.E(err, "invalid subscription message"))
+		return
 	}
 	newSubscriptions := event.ParseSubscriptions(event.NewSubscriptions)
 	oldSubscriptions := event.ParseSubscriptions(event.OldSubscriptions)
@@ -27,7 +47,11 @@ func Consume(delivery amqp.Delivery, storage storage.Addresses) {
 
 	switch event.Operation {
 	case UpdateSubscription:
-		err := storage.DeleteSubscriptions(oldSubscriptions)
+		err := UnsubscribeXpubs(storage, oldSubscriptions)
+		if err != nil {
+			logger.Error(err, params)
+		}
+		err = storage.DeleteSubscriptions(oldSubscriptions)
 		if err != nil {
 			logger.Error(err, params)
 		}
@@ -35,6 +59,11 @@ func Consume(delivery amqp.Delivery, storage storage.Addresses) {
 		if err != nil {
 			logger.Error(err, params)
 		}
+		err = SubscribeXpubs(storage, newSubscriptions)
+		if err != nil {
+			logger.Error(err, params)
+		}
+		setWebhook(event, storage, params)
 		err = delivery.Ack(false)
 		if err != nil {
 			logger.Error(err, params)
@@ -45,13 +74,22 @@ func Consume(delivery amqp.Delivery, storage storage.Addresses) {
 		if err != nil {
 			logger.Error(err, params)
 		}
+		err = SubscribeXpubs(storage, newSubscriptions)
+		if err != nil {
+			logger.Error(err, params)
+		}
+		setWebhook(event, storage, params)
 		err = delivery.Ack(false)
 		if err != nil {
 			logger.Error(err, params)
 		}
 		logger.Info("Added", params)
 	case DeleteSubscription:
-		err := storage.DeleteSubscriptions(oldSubscriptions)
+		err := UnsubscribeXpubs(storage, oldSubscriptions)
+		if err != nil {
+			logger.Error(err, params)
+		}
+		err = storage.DeleteSubscriptions(oldSubscriptions)
 		if err != nil {
 			logger.Error(err, params)
 		}
@@ -60,5 +98,41 @@ func Consume(delivery amqp.Delivery, storage storage.Addresses) {
 			logger.Error(err, params)
 		}
 		logger.Info("Deleted", params)
+	default:
+		reject(delivery, reasonUnknownOperation, errors.E("unknown subscription operation", errors.Params{"operation": event.Operation}))
+	}
+}
+
+// reject moves the message to the dead-letter queue with the error attached,
+// the message is requeued if the dead-letter queue is not available
+func reject(delivery amqp.Delivery, reason string, cause error) {
+	params := logger.Params{"reason": reason}
+	err := quarantine(delivery.Body, cause)
+	if err != nil {
+		logger.Error(err, "Failed to quarantine subscription message, requeueing", params)
+		if err := delivery.Nack(false, true); err != nil {
+			logger.Error(err, params)
+		}
+		return
+	}
+	quarantined.WithLabelValues(reason).Inc()
+	if err := delivery.Nack(false, false); err != nil {
+		logger.Error(err, params)
+	}
+	logger.Error(cause, "Subscription message quarantined", params)
+}
+
+// setWebhook stores the callback url of the guid for the webhook delivery mode
+func setWebhook(event blockatlas.SubscriptionEvent, storage storage.Webhooks, params logger.Params) {
+	if len(event.Webhook) == 0 {
+		return
+	}
+	err := storage.SetWebhook(blockatlas.Webhook{
+		GUID:   event.GUID,
+		URL:    event.Webhook,
+		Secret: event.WebhookSecret,
+	})
+	if err != nil {
+		logger.Error(err, params)
 	}
 }
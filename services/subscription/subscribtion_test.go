@@ -0,0 +1,66 @@
+package subscription
+
+import (
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/streadway/amqp"
+	"github.com/stretchr/testify/assert"
+	"github.com/trustwallet/blockatlas/pkg/errors"
+	"testing"
+)
+
+type acknowledger struct {
+	acked, requeued, dropped int
+}
+
+func (a *acknowledger) Ack(tag uint64, multiple bool) error {
+	a.acked++
+	return nil
+}
+
+func (a *acknowledger) Nack(tag uint64, multiple bool, requeue bool) error {
+	if requeue {
+		a.requeued++
+	} else {
+		a.dropped++
+	}
+	return nil
+}
+
+func (a *acknowledger) Reject(tag uint64, requeue bool) error {
+	return a.Nack(tag, false, requeue)
+}
+
+func TestConsume_quarantine(t *testing.T) {
+	defer func(original func([]byte, error) error) { quarantine = original }(quarantine)
+	var quarantinedBodies []string
+	var reasons []string
+	quarantine = func(body []byte, reason error) error {
+		quarantinedBodies = append(quarantinedBodies, string(body))
+		reasons = append(reasons, reason.Error())
+		return nil
+	}
+
+	invalidBefore := testutil.ToFloat64(quarantined.WithLabelValues(reasonInvalidJSON))
+	unknownBefore := testutil.ToFloat64(quarantined.WithLabelValues(reasonUnknownOperation))
+
+	ack := &acknowledger{}
+	Consume(amqp.Delivery{Acknowledger: ack, Body: []byte(`{"guid":`)}, nil)
+	Consume(amqp.Delivery{Acknowledger: ack, Body: []byte(`{"guid":"guid","operation":"Unknown"}`)}, nil)
+
+	assert.Equal(t, &acknowledger{dropped: 2}, ack)
+	assert.Equal(t, []string{`{"guid":`, `{"guid":"guid","operation":"Unknown"}`}, quarantinedBodies)
+	assert.Contains(t, reasons[0], "invalid subscription message")
+	assert.Contains(t, reasons[1], "unknown subscription operation")
+	assert.Equal(t, invalidBefore+1, testutil.ToFloat64(quarantined.WithLabelValues(reasonInvalidJSON)))
+	assert.Equal(t, unknownBefore+1, testutil.ToFloat64(quarantined.WithLabelValues(reasonUnknownOperation)))
+}
+
+func TestConsume_quarantineUnavailable(t *testing.T) {
+	defer func(original func([]byte, error) error) { quarantine = original }(quarantine)
+	quarantine = func(body []byte, reason error) error {
+		return errors.E("channel closed")
+	}
+	ack := &acknowledger{}
+	Consume(amqp.Delivery{Acknowledger: ack, Body: []byte(`not json`)}, nil)
+	assert.Equal(t, &acknowledger{requeued: 1}, ack)
+}
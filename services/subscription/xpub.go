@@ -0,0 +1,116 @@
+package subscription
+
+import (
+	"github.com/trustwallet/blockatlas/pkg/address"
+	"github.com/trustwallet/blockatlas/pkg/blockatlas"
+	"github.com/trustwallet/blockatlas/platform"
+	"github.com/trustwallet/blockatlas/storage"
+)
+
+// XpubStorage keeps the subscriptions along with the addresses derived from the xpubs
+type XpubStorage interface {
+	storage.Addresses
+	storage.Xpubs
+}
+
+// SubscribeXpubs subscribes the GUIDs of the extended public keys to the derived addresses.
+// The platform derives the used addresses and the unused ones up to the BIP44 gap limit,
+// the subscriptions which are not xpubs of a coin with an AddressAPI are ignored
+func SubscribeXpubs(storage XpubStorage, subscriptions []blockatlas.Subscription) error {
+	for _, sub := range subscriptions {
+		api, ok := xpubAPI(sub)
+		if !ok {
+			continue
+		}
+		addresses, _, err := deriveXpub(storage, api, sub.Coin, sub.Address)
+		if err != nil {
+			return err
+		}
+		err = storage.AddSubscriptions(subscribe(addresses, sub.Coin, sub.GUID))
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// UnsubscribeXpubs deletes the subscriptions of the GUIDs to the derived addresses of the xpubs,
+// the derived addresses are kept for the other GUIDs of the same xpub
+func UnsubscribeXpubs(storage XpubStorage, subscriptions []blockatlas.Subscription) error {
+	for _, sub := range subscriptions {
+		if !address.IsExtendedPubKey(sub.Address) {
+			continue
+		}
+		addresses, err := storage.GetXpubAddresses(sub.Coin, sub.Address)
+		if err != nil {
+			return err
+		}
+		err = storage.DeleteSubscriptions(subscribe(addresses, sub.Coin, sub.GUID))
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ExtendXpub derives the addresses of the xpub again and subscribes all its GUIDs to the new ones.
+// It's called when a derived address receives a transaction, so the gap limit window moves forward
+func ExtendXpub(storage XpubStorage, api blockatlas.AddressAPI, coin uint, xpub string) ([]string, error) {
+	_, added, err := deriveXpub(storage, api, coin, xpub)
+	if err != nil || len(added) == 0 {
+		return nil, err
+	}
+	subs, err := storage.Lookup(coin, []string{xpub})
+	if err != nil {
+		return nil, err
+	}
+	for _, sub := range subs {
+		err := storage.AddSubscriptions(subscribe(added, coin, sub.GUID))
+		if err != nil {
+			return nil, err
+		}
+	}
+	return added, nil
+}
+
+// deriveXpub returns all the known addresses of the xpub and the ones derived for the first time
+func deriveXpub(storage XpubStorage, api blockatlas.AddressAPI, coin uint, xpub string) (all, added []string, err error) {
+	derived, err := api.GetAddressesFromXpub(xpub)
+	if err != nil {
+		return nil, nil, err
+	}
+	all, err = storage.GetXpubAddresses(coin, xpub)
+	if err != nil {
+		return nil, nil, err
+	}
+	known := make(map[string]bool, len(all))
+	for _, a := range all {
+		known[a] = true
+	}
+	for _, a := range derived {
+		if !known[a] {
+			known[a] = true
+			added = append(added, a)
+		}
+	}
+	if err := storage.AddXpubAddresses(coin, xpub, added); err != nil {
+		return nil, nil, err
+	}
+	return append(all, added...), added, nil
+}
+
+func xpubAPI(sub blockatlas.Subscription) (blockatlas.AddressAPI, bool) {
+	if !address.IsExtendedPubKey(sub.Address) {
+		return nil, false
+	}
+	api, ok := platform.AddressAPIs[sub.Coin]
+	return api, ok
+}
+
+func subscribe(addresses []string, coin uint, guid string) []blockatlas.Subscription {
+	subs := make([]blockatlas.Subscription, 0, len(addresses))
+	for _, a := range addresses {
+		subs = append(subs, blockatlas.Subscription{Coin: coin, Address: a, GUID: guid})
+	}
+	return subs
+}
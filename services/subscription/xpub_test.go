@@ -0,0 +1,138 @@
+package subscription
+
+import (
+	"github.com/stretchr/testify/assert"
+	"github.com/trustwallet/blockatlas/coin"
+	"github.com/trustwallet/blockatlas/pkg/blockatlas"
+	"github.com/trustwallet/blockatlas/platform"
+	"sort"
+	"testing"
+)
+
+const testXpub = "zpub6ruK9k6YGm8BRHWvTiQcrEPnFkuRDJhR7mPYzV2LDvjpLa5CuGgrhCYVZjMGcLcFqv9b2WvsFtY2Gb3xq8NVq8qhk9veozrA2W9QaWtihrC"
+
+type addressAPI struct {
+	addresses []string
+}
+
+func (a *addressAPI) Coin() coin.Coin {
+	return coin.Coins[coin.BTC]
+}
+
+func (a *addressAPI) GetAddressesFromXpub(xpub string) ([]string, error) {
+	return a.addresses, nil
+}
+
+type xpubStorage struct {
+	subs  map[blockatlas.Subscription]bool
+	xpubs map[string][]string
+}
+
+func newXpubStorage() *xpubStorage {
+	return &xpubStorage{subs: make(map[blockatlas.Subscription]bool), xpubs: make(map[string][]string)}
+}
+
+func (s *xpubStorage) Lookup(coin uint, addresses []string) ([]blockatlas.Subscription, error) {
+	result := make([]blockatlas.Subscription, 0)
+	for sub := range s.subs {
+		for _, address := range addresses {
+			if sub.Coin == coin && sub.Address == address {
+				result = append(result, sub)
+			}
+		}
+	}
+	return result, nil
+}
+
+func (s *xpubStorage) AddSubscriptions(subscriptions []blockatlas.Subscription) error {
+	for _, sub := range subscriptions {
+		s.subs[sub] = true
+	}
+	return nil
+}
+
+func (s *xpubStorage) DeleteSubscriptions(subscriptions []blockatlas.Subscription) error {
+	for _, sub := range subscriptions {
+		delete(s.subs, sub)
+	}
+	return nil
+}
+
+func (s *xpubStorage) GetSubscriptions(guid string) ([]blockatlas.Subscription, error) {
+	result := make([]blockatlas.Subscription, 0)
+	for sub := range s.subs {
+		if sub.GUID == guid {
+			result = append(result, sub)
+		}
+	}
+	return result, nil
+}
+
+func (s *xpubStorage) GetXpubAddresses(coin uint, xpub string) ([]string, error) {
+	return s.xpubs[xpub], nil
+}
+
+func (s *xpubStorage) AddXpubAddresses(coin uint, xpub string, addresses []string) error {
+	s.xpubs[xpub] = append(s.xpubs[xpub], addresses...)
+	return nil
+}
+
+func (s *xpubStorage) LookupXpubs(coin uint, addresses []string) (map[string]string, error) {
+	result := make(map[string]string)
+	for xpub, derived := range s.xpubs {
+		for _, d := range derived {
+			for _, address := range addresses {
+				if d == address {
+					result[address] = xpub
+				}
+			}
+		}
+	}
+	return result, nil
+}
+
+func (s *xpubStorage) addresses(guid string) []string {
+	subs, _ := s.GetSubscriptions(guid)
+	addresses := make([]string, 0, len(subs))
+	for _, sub := range subs {
+		addresses = append(addresses, sub.Address)
+	}
+	sort.Strings(addresses)
+	return addresses
+}
+
+func TestSubscribeXpubs(t *testing.T) {
+	api := &addressAPI{addresses: []string{"a1", "a2"}}
+	platform.AddressAPIs = map[uint]blockatlas.AddressAPI{coin.BTC: api}
+	defer func() { platform.AddressAPIs = nil }()
+
+	storage := newXpubStorage()
+	subs := []blockatlas.Subscription{
+		{Coin: coin.BTC, Address: testXpub, GUID: "guid1"},
+		{Coin: coin.BTC, Address: "plain", GUID: "guid1"},
+		{Coin: coin.BTC, Address: testXpub, GUID: "guid2"},
+	}
+	assert.Nil(t, storage.AddSubscriptions(subs))
+	assert.Nil(t, SubscribeXpubs(storage, subs))
+	assert.Equal(t, []string{"a1", "a2", "plain", testXpub}, storage.addresses("guid1"))
+	assert.Equal(t, []string{"a1", "a2", testXpub}, storage.addresses("guid2"))
+
+	// A transaction of a derived address moves the window for all the GUIDs of the xpub
+	api.addresses = []string{"a1", "a2", "a3"}
+	added, err := ExtendXpub(storage, api, coin.BTC, testXpub)
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"a3"}, added)
+	assert.Equal(t, []string{"a1", "a2", "a3", "plain", testXpub}, storage.addresses("guid1"))
+	assert.Equal(t, []string{"a1", "a2", "a3", testXpub}, storage.addresses("guid2"))
+
+	added, err = ExtendXpub(storage, api, coin.BTC, testXpub)
+	assert.Nil(t, err)
+	assert.Len(t, added, 0)
+
+	// Unsubscribing keeps the derived addresses of the other GUIDs
+	unsubscribe := []blockatlas.Subscription{{Coin: coin.BTC, Address: testXpub, GUID: "guid2"}}
+	assert.Nil(t, UnsubscribeXpubs(storage, unsubscribe))
+	assert.Nil(t, storage.DeleteSubscriptions(unsubscribe))
+	assert.Equal(t, []string{}, storage.addresses("guid2"))
+	assert.Equal(t, []string{"a1", "a2", "a3", "plain", testXpub}, storage.addresses("guid1"))
+}
@@ -1,13 +1,21 @@
 package storage
 
 import (
+	"encoding/json"
+	stderrors "errors"
 	"fmt"
 	"github.com/trustwallet/blockatlas/pkg/blockatlas"
 	"github.com/trustwallet/blockatlas/pkg/errors"
+	"github.com/trustwallet/blockatlas/pkg/storage/redis"
+	"github.com/trustwallet/blockatlas/pkg/storage/util"
+	"strconv"
+	"strings"
 )
 
 const (
 	ATLAS_OBSERVER = "ATLAS_OBSERVER"
+	// ATLAS_GUIDS is the reverse index of ATLAS_OBSERVER: the subscription keys of every GUID
+	ATLAS_GUIDS = "ATLAS_GUIDS"
 )
 
 func (s *Storage) Lookup(coin uint, addresses []string) ([]blockatlas.Subscription, error) {
@@ -16,32 +24,84 @@ func (s *Storage) Lookup(coin uint, addresses []string) ([]blockatlas.Subscripti
 	}
 	observers := make([]blockatlas.Subscription, 0)
 	for _, address := range addresses {
-		key := getSubscriptionKey(coin, address)
-		var guids []string
-		err := s.GetHMValue(ATLAS_OBSERVER, key, &guids)
-		if err != nil {
-			continue
-		}
-		for _, guid := range guids {
-			observers = append(observers, blockatlas.Subscription{Coin: coin, Address: address, GUID: guid})
+		found := make(map[string]bool)
+		for _, lookupAddress := range lookupAddresses(coin, address) {
+			key := getSubscriptionKey(coin, lookupAddress)
+			var guids []string
+			err := s.GetHMValue(ATLAS_OBSERVER, key, &guids)
+			if err != nil {
+				continue
+			}
+			for _, guid := range guids {
+				if found[guid] {
+					continue
+				}
+				found[guid] = true
+				// The address is returned as requested, so it matches the transactions of the caller
+				observers = append(observers, blockatlas.Subscription{Coin: coin, Address: address, GUID: guid})
+			}
 		}
 	}
 	return observers, nil
 }
 
+// The GUIDs of a subscription key and the subscription keys of a GUID are JSON lists updated by Lua scripts,
+// so concurrent updates of the same key don't overwrite each other and both indexes change together
+
+var addSubscriptionScript = redis.NewScript(`
+local function add(hash, field, member)
+	local raw = redis.call('HGET', hash, field)
+	local members = {}
+	if raw then
+		members = cjson.decode(raw)
+		if type(members) ~= 'table' then
+			members = {}
+		end
+	end
+	for _, m in ipairs(members) do
+		if m == member then
+			return
+		end
+	end
+	table.insert(members, member)
+	redis.call('HSET', hash, field, cjson.encode(members))
+end
+add(KEYS[1], ARGV[1], ARGV[2])
+add(KEYS[2], ARGV[2], ARGV[1])
+return 1
+`)
+
+var deleteSubscriptionScript = redis.NewScript(`
+local function remove(hash, field, member)
+	local raw = redis.call('HGET', hash, field)
+	if not raw then
+		return
+	end
+	local members = cjson.decode(raw)
+	if type(members) ~= 'table' then
+		members = {}
+	end
+	local kept = {}
+	for _, m in ipairs(members) do
+		if m ~= member then
+			table.insert(kept, m)
+		end
+	end
+	if #kept == 0 then
+		redis.call('HDEL', hash, field)
+	else
+		redis.call('HSET', hash, field, cjson.encode(kept))
+	end
+end
+remove(KEYS[1], ARGV[1], ARGV[2])
+remove(KEYS[2], ARGV[2], ARGV[1])
+return 1
+`)
+
 func (s *Storage) AddSubscriptions(subscriptions []blockatlas.Subscription) error {
 	for _, sub := range subscriptions {
-		key := getSubscriptionKey(sub.Coin, sub.Address)
-		var guids []string
-		s.GetHMValue(ATLAS_OBSERVER, key, &guids)
-		if guids == nil {
-			guids = make([]string, 0)
-		}
-		if hasObject(guids, sub.GUID) {
-			continue
-		}
-		guids = append(guids, sub.GUID)
-		err := s.AddHM(ATLAS_OBSERVER, key, guids)
+		key := getSubscriptionKey(sub.Coin, normalizeAddress(sub.Coin, sub.Address))
+		err := s.RunScript(addSubscriptionScript, []string{ATLAS_OBSERVER, ATLAS_GUIDS}, key, sub.GUID)
 		if err != nil {
 			return err
 		}
@@ -51,40 +111,111 @@ func (s *Storage) AddSubscriptions(subscriptions []blockatlas.Subscription) erro
 
 func (s *Storage) DeleteSubscriptions(subscriptions []blockatlas.Subscription) error {
 	for _, sub := range subscriptions {
-		key := getSubscriptionKey(sub.Coin, sub.Address)
+		for _, address := range lookupAddresses(sub.Coin, sub.Address) {
+			key := getSubscriptionKey(sub.Coin, address)
+			err := s.RunScript(deleteSubscriptionScript, []string{ATLAS_OBSERVER, ATLAS_GUIDS}, key, sub.GUID)
+			if err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// backfillGuidScript adds the subscription key to the reverse index of the GUID,
+// unless the subscription has been deleted since ATLAS_OBSERVER was scanned
+var backfillGuidScript = redis.NewScript(`
+local raw = redis.call('HGET', KEYS[1], ARGV[1])
+if not raw then
+	return 0
+end
+local guids = cjson.decode(raw)
+if type(guids) ~= 'table' then
+	return 0
+end
+local found = false
+for _, g in ipairs(guids) do
+	if g == ARGV[2] then
+		found = true
+	end
+end
+if not found then
+	return 0
+end
+local keys = {}
+raw = redis.call('HGET', KEYS[2], ARGV[2])
+if raw then
+	keys = cjson.decode(raw)
+	if type(keys) ~= 'table' then
+		keys = {}
+	end
+end
+for _, k in ipairs(keys) do
+	if k == ARGV[1] then
+		return 0
+	end
+end
+table.insert(keys, ARGV[1])
+redis.call('HSET', KEYS[2], ARGV[2], cjson.encode(keys))
+return 1
+`)
+
+// BackfillGuids adds the subscriptions of ATLAS_OBSERVER which are missing from the ATLAS_GUIDS index,
+// the ones added before the index existed. It returns the number of scanned subscriptions
+func (s *Storage) BackfillGuids() (int, error) {
+	count := 0
+	err := s.ScanHM(ATLAS_OBSERVER, func(key, value string) error {
 		var guids []string
-		err := s.GetHMValue(ATLAS_OBSERVER, key, &guids)
-		if err != nil {
-			continue
+		if err := json.Unmarshal([]byte(value), &guids); err != nil {
+			return errors.E(err, "invalid subscription", errors.Params{"key": key})
 		}
-		newHooks := make([]string, 0)
 		for _, guid := range guids {
-			if guid == sub.GUID {
-				continue
+			err := s.RunScript(backfillGuidScript, []string{ATLAS_OBSERVER, ATLAS_GUIDS}, key, guid)
+			if err != nil {
+				return err
 			}
-			newHooks = append(newHooks, guid)
+			count++
 		}
-		if len(newHooks) == 0 {
-			_ = s.DeleteHM(ATLAS_OBSERVER, key)
-			continue
-		}
-		err = s.AddHM(ATLAS_OBSERVER, key, newHooks)
+		return nil
+	})
+	return count, err
+}
+
+// GetSubscriptions returns the subscriptions of the GUID from the reverse index,
+// the subscriptions added before the index existed are listed once BackfillGuids indexed them
+func (s *Storage) GetSubscriptions(guid string) ([]blockatlas.Subscription, error) {
+	var keys []string
+	err := s.GetHMValue(ATLAS_GUIDS, guid, &keys)
+	// Same as Lookup, a GUID without subscriptions has no field in the index
+	if err != nil && stderrors.Is(err, util.ErrNotFound) {
+		return []blockatlas.Subscription{}, nil
+	}
+	if err != nil {
+		return nil, errors.E(err, errors.Params{"guid": guid})
+	}
+	subs := make([]blockatlas.Subscription, 0, len(keys))
+	for _, key := range keys {
+		coin, address, err := parseSubscriptionKey(key)
 		if err != nil {
-			return err
+			return nil, err
 		}
+		subs = append(subs, blockatlas.Subscription{Coin: coin, Address: address, GUID: guid})
 	}
-	return nil
+	return subs, nil
 }
 
 func getSubscriptionKey(coin uint, address string) string {
 	return fmt.Sprintf("%d-%s", coin, address)
 }
 
-func hasObject(array []string, obj string) bool {
-	for _, temp := range array {
-		if temp == obj {
-			return true
-		}
+func parseSubscriptionKey(key string) (uint, string, error) {
+	parts := strings.SplitN(key, "-", 2)
+	if len(parts) != 2 {
+		return 0, "", errors.E("invalid subscription key", errors.Params{"key": key})
+	}
+	coin, err := strconv.ParseUint(parts[0], 10, 32)
+	if err != nil {
+		return 0, "", errors.E(err, "invalid subscription key", errors.Params{"key": key})
 	}
-	return false
+	return uint(coin), parts[1], nil
 }
@@ -0,0 +1,87 @@
+package storage
+
+import (
+	"github.com/alicebob/miniredis"
+	"github.com/stretchr/testify/assert"
+	"github.com/trustwallet/blockatlas/coin"
+	"github.com/trustwallet/blockatlas/pkg/blockatlas"
+	"testing"
+)
+
+func newTestStorage(t *testing.T) (*Storage, *miniredis.Miniredis) {
+	mr, err := miniredis.Run()
+	if err != nil {
+		t.Fatal(err)
+	}
+	s := New()
+	if err := s.Init("redis://" + mr.Addr()); err != nil {
+		t.Fatal(err)
+	}
+	return s, mr
+}
+
+func TestStorage_Subscriptions(t *testing.T) {
+	s, mr := newTestStorage(t)
+	defer mr.Close()
+
+	first := blockatlas.Subscription{Coin: coin.BTC, Address: "bc1qfirst", GUID: "guid1"}
+	second := blockatlas.Subscription{Coin: coin.BTC, Address: "bc1qsecond", GUID: "guid1"}
+	other := blockatlas.Subscription{Coin: coin.BTC, Address: "bc1qfirst", GUID: "guid2"}
+	assert.Nil(t, s.AddSubscriptions([]blockatlas.Subscription{first, second, other, first}))
+
+	subs, err := s.GetSubscriptions("guid1")
+	assert.Nil(t, err)
+	assert.ElementsMatch(t, []blockatlas.Subscription{first, second}, subs)
+	assert.Equal(t, `["guid1","guid2"]`, mr.HGet(ATLAS_OBSERVER, "0-bc1qfirst"))
+
+	subs, err = s.Lookup(coin.BTC, []string{"bc1qfirst"})
+	assert.Nil(t, err)
+	assert.ElementsMatch(t, []blockatlas.Subscription{first, other}, subs)
+
+	// Both indexes drop the subscription, and the fields left empty
+	assert.Nil(t, s.DeleteSubscriptions([]blockatlas.Subscription{first, second}))
+	subs, err = s.GetSubscriptions("guid1")
+	assert.Nil(t, err)
+	assert.Empty(t, subs)
+	assert.Equal(t, "", mr.HGet(ATLAS_GUIDS, "guid1"))
+	assert.Equal(t, "", mr.HGet(ATLAS_OBSERVER, "0-bc1qsecond"))
+	assert.Equal(t, `["guid2"]`, mr.HGet(ATLAS_OBSERVER, "0-bc1qfirst"))
+
+	subs, err = s.GetSubscriptions("guid2")
+	assert.Nil(t, err)
+	assert.Equal(t, []blockatlas.Subscription{other}, subs)
+}
+
+func TestStorage_BackfillGuids(t *testing.T) {
+	s, mr := newTestStorage(t)
+	defer mr.Close()
+
+	// Subscriptions stored before the GUID index existed
+	mr.HSet(ATLAS_OBSERVER, "0-bc1qfirst", `["guid1","guid2"]`)
+	mr.HSet(ATLAS_OBSERVER, "60-0xsecond", `["guid1"]`)
+	indexed := blockatlas.Subscription{Coin: coin.BTC, Address: "bc1qthird", GUID: "guid1"}
+	assert.Nil(t, s.AddSubscriptions([]blockatlas.Subscription{indexed}))
+
+	count, err := s.BackfillGuids()
+	assert.Nil(t, err)
+	assert.Equal(t, 4, count)
+
+	subs, err := s.GetSubscriptions("guid1")
+	assert.Nil(t, err)
+	assert.ElementsMatch(t, []blockatlas.Subscription{
+		{Coin: coin.BTC, Address: "bc1qfirst", GUID: "guid1"},
+		{Coin: coin.ETH, Address: "0xsecond", GUID: "guid1"},
+		indexed,
+	}, subs)
+
+	// Running it again doesn't index the subscriptions twice
+	_, err = s.BackfillGuids()
+	assert.Nil(t, err)
+	subs, err = s.GetSubscriptions("guid1")
+	assert.Nil(t, err)
+	assert.Len(t, subs, 3)
+
+	mr.HSet(ATLAS_OBSERVER, "0-bc1qbroken", `{`)
+	_, err = s.BackfillGuids()
+	assert.NotNil(t, err)
+}
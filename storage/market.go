@@ -0,0 +1,60 @@
+package storage
+
+import (
+	"fmt"
+	"github.com/trustwallet/blockatlas/pkg/blockatlas"
+	"github.com/trustwallet/blockatlas/pkg/errors"
+	"strings"
+)
+
+const (
+	ATLAS_MARKET_TICKERS = "ATLAS_MARKET_TICKERS"
+	ATLAS_MARKET_RATES   = "ATLAS_MARKET_RATES"
+)
+
+func (s *Storage) SaveTickers(tickers blockatlas.Tickers) error {
+	if len(tickers) == 0 {
+		return nil
+	}
+	values := make(map[string]interface{}, len(tickers))
+	for _, t := range tickers {
+		values[tickerKey(t.Coin, t.TokenId)] = t
+	}
+	return s.AddManyHM(ATLAS_MARKET_TICKERS, values)
+}
+
+func (s *Storage) GetTicker(coin uint, token string) (*blockatlas.Ticker, error) {
+	var ticker blockatlas.Ticker
+	err := s.GetHMValue(ATLAS_MARKET_TICKERS, tickerKey(coin, token), &ticker)
+	if err != nil {
+		return nil, errors.E(err, errors.Params{"coin": coin, "token": token})
+	}
+	return &ticker, nil
+}
+
+func (s *Storage) SaveRates(rates blockatlas.Rates) error {
+	if len(rates) == 0 {
+		return nil
+	}
+	values := make(map[string]interface{}, len(rates))
+	for _, r := range rates {
+		values[strings.ToUpper(r.Currency)] = r
+	}
+	return s.AddManyHM(ATLAS_MARKET_RATES, values)
+}
+
+func (s *Storage) GetRate(currency string) (*blockatlas.Rate, error) {
+	var rate blockatlas.Rate
+	err := s.GetHMValue(ATLAS_MARKET_RATES, strings.ToUpper(currency), &rate)
+	if err != nil {
+		return nil, errors.E(err, errors.Params{"currency": currency})
+	}
+	return &rate, nil
+}
+
+func tickerKey(coin uint, token string) string {
+	if token == "" {
+		return fmt.Sprintf("%d", coin)
+	}
+	return fmt.Sprintf("%d-%s", coin, strings.ToLower(token))
+}
@@ -0,0 +1,86 @@
+package storage
+
+import (
+	"github.com/jinzhu/gorm"
+	"github.com/trustwallet/blockatlas/pkg/errors"
+	"github.com/trustwallet/blockatlas/pkg/logger"
+)
+
+type migration struct {
+	version int
+	query   string
+}
+
+// Migrations are applied in order and never changed once released, add a new version instead
+var migrations = []migration{
+	{1, `CREATE TABLE IF NOT EXISTS subscriptions (
+		coin integer NOT NULL,
+		address varchar(128) NOT NULL,
+		guid varchar(128) NOT NULL,
+		created_at timestamp NOT NULL DEFAULT now(),
+		PRIMARY KEY (coin, address, guid)
+	)`},
+	{2, `CREATE INDEX IF NOT EXISTS subscriptions_guid_idx ON subscriptions (guid)`},
+	{3, `CREATE TABLE IF NOT EXISTS block_heights (
+		coin integer PRIMARY KEY,
+		height bigint NOT NULL,
+		updated_at timestamp NOT NULL DEFAULT now()
+	)`},
+	{4, `CREATE TABLE IF NOT EXISTS webhooks (
+		guid varchar(128) PRIMARY KEY,
+		url varchar(2048) NOT NULL,
+		secret varchar(256) NOT NULL DEFAULT '',
+		updated_at timestamp NOT NULL DEFAULT now()
+	)`},
+	{5, `CREATE TABLE IF NOT EXISTS outbox (
+		id varchar(64) PRIMARY KEY,
+		guid varchar(128) NOT NULL,
+		body text NOT NULL,
+		attempts integer NOT NULL DEFAULT 0,
+		dead boolean NOT NULL DEFAULT false,
+		error text NOT NULL DEFAULT '',
+		created bigint NOT NULL,
+		updated bigint NOT NULL
+	)`},
+	{6, `CREATE TABLE IF NOT EXISTS xpub_addresses (
+		coin integer NOT NULL,
+		address varchar(128) NOT NULL,
+		xpub varchar(128) NOT NULL,
+		PRIMARY KEY (coin, address)
+	)`},
+	{7, `CREATE INDEX IF NOT EXISTS xpub_addresses_xpub ON xpub_addresses (coin, xpub)`},
+}
+
+// Migrate brings the database schema up to the latest version
+func (s *SqlStorage) Migrate() error {
+	err := s.Client.Exec(`CREATE TABLE IF NOT EXISTS schema_migrations (
+		version integer PRIMARY KEY,
+		applied_at timestamp NOT NULL DEFAULT now()
+	)`).Error
+	if err != nil {
+		return errors.E(err, "cannot create schema_migrations table")
+	}
+
+	var current int
+	row := s.Client.Raw("SELECT COALESCE(MAX(version), 0) FROM schema_migrations").Row()
+	if err := row.Scan(&current); err != nil {
+		return errors.E(err, "cannot read schema version")
+	}
+
+	for _, m := range migrations {
+		if m.version <= current {
+			continue
+		}
+		err := s.Client.Transaction(func(tx *gorm.DB) error {
+			if err := tx.Exec(m.query).Error; err != nil {
+				return err
+			}
+			return tx.Exec("INSERT INTO schema_migrations (version) VALUES (?)", m.version).Error
+		})
+		if err != nil {
+			return errors.E(err, "migration failed", errors.Params{"version": m.version})
+		}
+		logger.Info("Applied migration", logger.Params{"version": m.version})
+	}
+	return nil
+}
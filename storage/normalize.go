@@ -0,0 +1,25 @@
+package storage
+
+import (
+	"github.com/trustwallet/blockatlas/pkg/address"
+)
+
+// normalizeAddress returns the canonical form of the address, so the subscriptions match the transactions
+// whatever case or prefix the wallet and the blockchain use. Unknown formats are kept as is
+func normalizeAddress(coin uint, addr string) string {
+	normalized, err := address.Normalize(coin, addr)
+	if err != nil {
+		return addr
+	}
+	return normalized
+}
+
+// lookupAddresses returns the canonical form of the address and the address itself
+// when it differs, which is the key of the subscriptions stored before the normalization
+func lookupAddresses(coin uint, addr string) []string {
+	normalized := normalizeAddress(coin, addr)
+	if normalized == addr {
+		return []string{addr}
+	}
+	return []string{normalized, addr}
+}
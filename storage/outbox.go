@@ -0,0 +1,39 @@
+package storage
+
+import (
+	"encoding/json"
+	"github.com/trustwallet/blockatlas/pkg/blockatlas"
+	"github.com/trustwallet/blockatlas/pkg/errors"
+	"sort"
+)
+
+const (
+	ATLAS_OUTBOX = "ATLAS_OUTBOX"
+)
+
+func (s *Storage) SaveOutboxEvent(event blockatlas.OutboxEvent) error {
+	return s.AddHM(ATLAS_OUTBOX, event.ID, event)
+}
+
+func (s *Storage) DeleteOutboxEvent(id string) error {
+	return s.DeleteHM(ATLAS_OUTBOX, id)
+}
+
+func (s *Storage) GetOutboxEvents() ([]blockatlas.OutboxEvent, error) {
+	values, err := s.GetAllHM(ATLAS_OUTBOX)
+	if err != nil {
+		return nil, err
+	}
+	events := make([]blockatlas.OutboxEvent, 0, len(values))
+	for id, value := range values {
+		var event blockatlas.OutboxEvent
+		if err := json.Unmarshal([]byte(value), &event); err != nil {
+			return nil, errors.E(err, errors.Params{"id": id})
+		}
+		events = append(events, event)
+	}
+	sort.Slice(events, func(i, j int) bool {
+		return events[i].Created < events[j].Created
+	})
+	return events, nil
+}
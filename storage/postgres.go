@@ -0,0 +1,282 @@
+package storage
+
+import (
+	"github.com/jinzhu/gorm"
+	"github.com/trustwallet/blockatlas/pkg/blockatlas"
+	"github.com/trustwallet/blockatlas/pkg/errors"
+	"github.com/trustwallet/blockatlas/pkg/storage/sql"
+	"github.com/trustwallet/blockatlas/pkg/storage/util"
+)
+
+type subscription struct {
+	Coin    uint   `gorm:"primary_key;auto_increment:false"`
+	Address string `gorm:"primary_key"`
+	GUID    string `gorm:"column:guid;primary_key"`
+}
+
+func (subscription) TableName() string {
+	return "subscriptions"
+}
+
+type webhook struct {
+	GUID   string `gorm:"column:guid;primary_key"`
+	URL    string
+	Secret string
+}
+
+func (webhook) TableName() string {
+	return "webhooks"
+}
+
+type outboxEvent struct {
+	ID       string `gorm:"primary_key"`
+	GUID     string `gorm:"column:guid"`
+	Body     string
+	Attempts int
+	Dead     bool
+	Error    string
+	Created  int64
+	Updated  int64
+}
+
+func (outboxEvent) TableName() string {
+	return "outbox"
+}
+
+type xpubAddress struct {
+	Coin    uint   `gorm:"primary_key;auto_increment:false"`
+	Address string `gorm:"primary_key"`
+	Xpub    string
+}
+
+func (xpubAddress) TableName() string {
+	return "xpub_addresses"
+}
+
+type blockHeight struct {
+	Coin   uint `gorm:"primary_key;auto_increment:false"`
+	Height int64
+}
+
+func (blockHeight) TableName() string {
+	return "block_heights"
+}
+
+// SqlStorage keeps the subscriptions and the observer block heights in PostgreSQL
+type SqlStorage struct {
+	sql.PgSql
+}
+
+func NewSql() *SqlStorage {
+	return new(SqlStorage)
+}
+
+func (s *SqlStorage) Lookup(coin uint, addresses []string) ([]blockatlas.Subscription, error) {
+	if len(addresses) == 0 {
+		return nil, errors.E("cannot look up an empty list")
+	}
+	// The stored addresses are mapped back to the requested ones, so they match the transactions of the caller
+	requested := make(map[string][]string)
+	for _, address := range addresses {
+		for _, lookupAddress := range lookupAddresses(coin, address) {
+			requested[lookupAddress] = append(requested[lookupAddress], address)
+		}
+	}
+	stored := make([]string, 0, len(requested))
+	for address := range requested {
+		stored = append(stored, address)
+	}
+	var subs []subscription
+	err := s.Client.Where("coin = ? AND address IN (?)", coin, stored).Find(&subs).Error
+	if err != nil {
+		return nil, errors.E(err, util.ErrNotFound, errors.Params{"coin": coin})
+	}
+	found := make(map[string]bool)
+	observers := make([]blockatlas.Subscription, 0, len(subs))
+	for _, sub := range subs {
+		for _, address := range requested[sub.Address] {
+			key := address + "-" + sub.GUID
+			if found[key] {
+				continue
+			}
+			found[key] = true
+			observers = append(observers, blockatlas.Subscription{Coin: sub.Coin, Address: address, GUID: sub.GUID})
+		}
+	}
+	return observers, nil
+}
+
+func (s *SqlStorage) GetSubscriptions(guid string) ([]blockatlas.Subscription, error) {
+	var subs []subscription
+	err := s.Client.Where("guid = ?", guid).Order("coin, address").Find(&subs).Error
+	if err != nil {
+		return nil, errors.E(err, util.ErrNotFound, errors.Params{"guid": guid})
+	}
+	result := make([]blockatlas.Subscription, 0, len(subs))
+	for _, sub := range subs {
+		result = append(result, blockatlas.Subscription{Coin: sub.Coin, Address: sub.Address, GUID: sub.GUID})
+	}
+	return result, nil
+}
+
+func (s *SqlStorage) AddSubscriptions(subscriptions []blockatlas.Subscription) error {
+	return s.Client.Transaction(func(tx *gorm.DB) error {
+		for _, sub := range subscriptions {
+			err := tx.Exec("INSERT INTO subscriptions (coin, address, guid) VALUES (?, ?, ?) ON CONFLICT DO NOTHING",
+				sub.Coin, normalizeAddress(sub.Coin, sub.Address), sub.GUID).Error
+			if err != nil {
+				return errors.E(err, util.ErrNotStored, errors.Params{"coin": sub.Coin, "address": sub.Address})
+			}
+		}
+		return nil
+	})
+}
+
+func (s *SqlStorage) DeleteSubscriptions(subscriptions []blockatlas.Subscription) error {
+	return s.Client.Transaction(func(tx *gorm.DB) error {
+		for _, sub := range subscriptions {
+			err := tx.Where("coin = ? AND address IN (?) AND guid = ?", sub.Coin, lookupAddresses(sub.Coin, sub.Address), sub.GUID).
+				Delete(subscription{}).Error
+			if err != nil {
+				return errors.E(err, util.ErrNotDeleted, errors.Params{"coin": sub.Coin, "address": sub.Address})
+			}
+		}
+		return nil
+	})
+}
+
+func (s *SqlStorage) GetBlockNumber(coin uint) (int64, error) {
+	var b blockHeight
+	err := s.Client.Where("coin = ?", coin).Take(&b).Error
+	if gorm.IsRecordNotFoundError(err) {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, errors.E(err, util.ErrNotFound, errors.Params{"coin": coin})
+	}
+	return b.Height, nil
+}
+
+func (s *SqlStorage) SetBlockNumber(coin uint, num int64) error {
+	err := s.Client.Exec("INSERT INTO block_heights (coin, height) VALUES (?, ?) ON CONFLICT (coin) DO UPDATE SET height = EXCLUDED.height",
+		coin, num).Error
+	if err != nil {
+		return errors.E(err, util.ErrNotStored, errors.Params{"coin": coin, "block": num})
+	}
+	return nil
+}
+
+func (s *SqlStorage) GetWebhook(guid string) (*blockatlas.Webhook, error) {
+	var w webhook
+	err := s.Client.Where("guid = ?", guid).Take(&w).Error
+	if err != nil {
+		return nil, errors.E(err, util.ErrNotFound, errors.Params{"guid": guid})
+	}
+	return &blockatlas.Webhook{GUID: w.GUID, URL: w.URL, Secret: w.Secret}, nil
+}
+
+func (s *SqlStorage) SetWebhook(w blockatlas.Webhook) error {
+	err := s.Client.Exec("INSERT INTO webhooks (guid, url, secret) VALUES (?, ?, ?) ON CONFLICT (guid) DO UPDATE SET url = EXCLUDED.url, secret = EXCLUDED.secret, updated_at = now()",
+		w.GUID, w.URL, w.Secret).Error
+	if err != nil {
+		return errors.E(err, util.ErrNotStored, errors.Params{"guid": w.GUID})
+	}
+	return nil
+}
+
+func (s *SqlStorage) DeleteWebhook(guid string) error {
+	err := s.Client.Where("guid = ?", guid).Delete(webhook{}).Error
+	if err != nil {
+		return errors.E(err, util.ErrNotDeleted, errors.Params{"guid": guid})
+	}
+	return nil
+}
+
+func (s *SqlStorage) SaveOutboxEvent(e blockatlas.OutboxEvent) error {
+	err := s.Client.Save(&outboxEvent{
+		ID:       e.ID,
+		GUID:     e.GUID,
+		Body:     string(e.Body),
+		Attempts: e.Attempts,
+		Dead:     e.Dead,
+		Error:    e.Error,
+		Created:  e.Created,
+		Updated:  e.Updated,
+	}).Error
+	if err != nil {
+		return errors.E(err, util.ErrNotStored, errors.Params{"id": e.ID})
+	}
+	return nil
+}
+
+func (s *SqlStorage) DeleteOutboxEvent(id string) error {
+	err := s.Client.Where("id = ?", id).Delete(outboxEvent{}).Error
+	if err != nil {
+		return errors.E(err, util.ErrNotDeleted, errors.Params{"id": id})
+	}
+	return nil
+}
+
+func (s *SqlStorage) GetOutboxEvents() ([]blockatlas.OutboxEvent, error) {
+	var rows []outboxEvent
+	err := s.Client.Order("created").Find(&rows).Error
+	if err != nil {
+		return nil, errors.E(err, util.ErrNotFound)
+	}
+	events := make([]blockatlas.OutboxEvent, 0, len(rows))
+	for _, e := range rows {
+		events = append(events, blockatlas.OutboxEvent{
+			ID:       e.ID,
+			GUID:     e.GUID,
+			Body:     []byte(e.Body),
+			Attempts: e.Attempts,
+			Dead:     e.Dead,
+			Error:    e.Error,
+			Created:  e.Created,
+			Updated:  e.Updated,
+		})
+	}
+	return events, nil
+}
+
+func (s *SqlStorage) GetXpubAddresses(coin uint, xpub string) ([]string, error) {
+	var rows []xpubAddress
+	err := s.Client.Where("coin = ? AND xpub = ?", coin, xpub).Find(&rows).Error
+	if err != nil {
+		return nil, errors.E(err, util.ErrNotFound, errors.Params{"coin": coin})
+	}
+	addresses := make([]string, 0, len(rows))
+	for _, row := range rows {
+		addresses = append(addresses, row.Address)
+	}
+	return addresses, nil
+}
+
+func (s *SqlStorage) AddXpubAddresses(coin uint, xpub string, addresses []string) error {
+	return s.Client.Transaction(func(tx *gorm.DB) error {
+		for _, address := range addresses {
+			err := tx.Exec("INSERT INTO xpub_addresses (coin, address, xpub) VALUES (?, ?, ?) ON CONFLICT DO NOTHING",
+				coin, address, xpub).Error
+			if err != nil {
+				return errors.E(err, util.ErrNotStored, errors.Params{"coin": coin, "address": address})
+			}
+		}
+		return nil
+	})
+}
+
+func (s *SqlStorage) LookupXpubs(coin uint, addresses []string) (map[string]string, error) {
+	xpubs := make(map[string]string)
+	if len(addresses) == 0 {
+		return xpubs, nil
+	}
+	var rows []xpubAddress
+	err := s.Client.Where("coin = ? AND address IN (?)", coin, addresses).Find(&rows).Error
+	if err != nil {
+		return nil, errors.E(err, util.ErrNotFound, errors.Params{"coin": coin})
+	}
+	for _, row := range rows {
+		xpubs[row.Address] = row.Xpub
+	}
+	return xpubs, nil
+}
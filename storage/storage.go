@@ -39,6 +39,11 @@ func RestoreConnectionWorker(storage *Storage, uri string, timeout time.Duration
 	}
 }
 
+const (
+	BackendRedis    = "redis"
+	BackendPostgres = "postgres"
+)
+
 type Tracker interface {
 	GetBlockNumber(coin uint) (int64, error)
 	SetBlockNumber(coin uint, num int64) error
@@ -48,4 +53,35 @@ type Addresses interface {
 	Lookup(coin uint, addresses []string) ([]blockatlas.Subscription, error)
 	AddSubscriptions(subscriptions []blockatlas.Subscription) error
 	DeleteSubscriptions(subscriptions []blockatlas.Subscription) error
+	GetSubscriptions(guid string) ([]blockatlas.Subscription, error)
+}
+
+type Webhooks interface {
+	GetWebhook(guid string) (*blockatlas.Webhook, error)
+	SetWebhook(webhook blockatlas.Webhook) error
+	DeleteWebhook(guid string) error
+}
+
+// Xpubs keeps the addresses derived from the subscribed extended public keys
+type Xpubs interface {
+	GetXpubAddresses(coin uint, xpub string) ([]string, error)
+	AddXpubAddresses(coin uint, xpub string, addresses []string) error
+	// LookupXpubs returns the xpubs of the derived addresses by address
+	LookupXpubs(coin uint, addresses []string) (map[string]string, error)
+}
+
+// Outbox keeps the observer events until the delivery is confirmed
+type Outbox interface {
+	SaveOutboxEvent(event blockatlas.OutboxEvent) error
+	DeleteOutboxEvent(id string) error
+	GetOutboxEvents() ([]blockatlas.OutboxEvent, error)
+}
+
+// Backend stores the subscriptions, the xpubs, the webhooks, the event outbox and the observer block heights
+type Backend interface {
+	Tracker
+	Addresses
+	Webhooks
+	Outbox
+	Xpubs
 }
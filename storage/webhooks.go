@@ -0,0 +1,26 @@
+package storage
+
+import (
+	"github.com/trustwallet/blockatlas/pkg/blockatlas"
+)
+
+const (
+	ATLAS_WEBHOOKS = "ATLAS_WEBHOOKS"
+)
+
+func (s *Storage) GetWebhook(guid string) (*blockatlas.Webhook, error) {
+	var webhook blockatlas.Webhook
+	err := s.GetHMValue(ATLAS_WEBHOOKS, guid, &webhook)
+	if err != nil {
+		return nil, err
+	}
+	return &webhook, nil
+}
+
+func (s *Storage) SetWebhook(webhook blockatlas.Webhook) error {
+	return s.AddHM(ATLAS_WEBHOOKS, webhook.GUID, webhook)
+}
+
+func (s *Storage) DeleteWebhook(guid string) error {
+	return s.DeleteHM(ATLAS_WEBHOOKS, guid)
+}
@@ -0,0 +1,86 @@
+package storage
+
+import (
+	"encoding/json"
+	"github.com/trustwallet/blockatlas/pkg/errors"
+	"github.com/trustwallet/blockatlas/pkg/storage/redis"
+)
+
+const (
+	// ATLAS_XPUBS keeps the derived addresses of every xpub
+	ATLAS_XPUBS = "ATLAS_XPUBS"
+	// ATLAS_XPUB_ADDRESSES is the reverse index of ATLAS_XPUBS: the xpub of every derived address
+	ATLAS_XPUB_ADDRESSES = "ATLAS_XPUB_ADDRESSES"
+)
+
+func (s *Storage) GetXpubAddresses(coin uint, xpub string) ([]string, error) {
+	var addresses []string
+	err := s.GetHMValue(ATLAS_XPUBS, getSubscriptionKey(coin, xpub), &addresses)
+	if err != nil {
+		// An xpub without derived addresses has no field
+		return []string{}, nil
+	}
+	return addresses, nil
+}
+
+// addXpubAddressesScript merges the addresses into the JSON list of the xpub and indexes their xpub,
+// so concurrent derivations of the same xpub don't overwrite each other
+var addXpubAddressesScript = redis.NewScript(`
+local raw = redis.call('HGET', KEYS[1], ARGV[1])
+local known = {}
+if raw then
+	known = cjson.decode(raw)
+	if type(known) ~= 'table' then
+		known = {}
+	end
+end
+local exists = {}
+for _, address in ipairs(known) do
+	exists[address] = true
+end
+for i = 4, #ARGV do
+	local address = ARGV[i]
+	redis.call('HSET', KEYS[2], ARGV[3] .. '-' .. address, ARGV[2])
+	if not exists[address] then
+		exists[address] = true
+		table.insert(known, address)
+	end
+end
+redis.call('HSET', KEYS[1], ARGV[1], cjson.encode(known))
+return 1
+`)
+
+// AddXpubAddresses merges the addresses into the list of the xpub
+func (s *Storage) AddXpubAddresses(coin uint, xpub string, addresses []string) error {
+	if len(addresses) == 0 {
+		return nil
+	}
+	// The reverse index keeps the xpub as JSON like the other hash values
+	value, err := json.Marshal(xpub)
+	if err != nil {
+		return errors.E(err, errors.Params{"coin": coin})
+	}
+	args := make([]interface{}, 0, len(addresses)+3)
+	args = append(args, getSubscriptionKey(coin, xpub), string(value), coin)
+	for _, address := range addresses {
+		args = append(args, address)
+	}
+	err = s.RunScript(addXpubAddressesScript, []string{ATLAS_XPUBS, ATLAS_XPUB_ADDRESSES}, args...)
+	if err != nil {
+		return errors.E(err, errors.Params{"coin": coin})
+	}
+	return nil
+}
+
+func (s *Storage) LookupXpubs(coin uint, addresses []string) (map[string]string, error) {
+	xpubs := make(map[string]string)
+	for _, address := range addresses {
+		var xpub string
+		err := s.GetHMValue(ATLAS_XPUB_ADDRESSES, getSubscriptionKey(coin, address), &xpub)
+		if err != nil {
+			continue
+		}
+		xpubs[address] = xpub
+	}
+	return xpubs, nil
+}
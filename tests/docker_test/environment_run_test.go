@@ -11,8 +11,10 @@ import (
 func TestMain(m *testing.M) {
 	setup.RunMQContainer()
 	setup.RunRedisContainer()
+	setup.RunPostgresContainer()
 	code := m.Run()
 	setup.StopMQContainer()
 	setup.StopRedisContainer()
+	setup.StopPostgresContainer()
 	os.Exit(code)
 }
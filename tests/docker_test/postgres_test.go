@@ -0,0 +1,50 @@
+// +build integration
+
+package docker_test
+
+import (
+	"github.com/stretchr/testify/assert"
+	"github.com/trustwallet/blockatlas/pkg/blockatlas"
+	"github.com/trustwallet/blockatlas/tests/docker_test/setup"
+	"testing"
+)
+
+func TestSqlStorageSubscriptions(t *testing.T) {
+	subs := []blockatlas.Subscription{
+		{Coin: 0, Address: "bc1qhn03cww757mnnlpkdvvfkaydxqygm86nvkm92h", GUID: "guid-1"},
+		{Coin: 0, Address: "bc1qhn03cww757mnnlpkdvvfkaydxqygm86nvkm92h", GUID: "guid-2"},
+		{Coin: 60, Address: "0x5574Cd97432cEd0D7Caf58ac3c4fEDB2061C98fB", GUID: "guid-1"},
+	}
+	assert.Nil(t, setup.Database.AddSubscriptions(subs))
+	// Adding the same subscriptions again must not fail nor duplicate them
+	assert.Nil(t, setup.Database.AddSubscriptions(subs))
+
+	result, err := setup.Database.Lookup(0, []string{"bc1qhn03cww757mnnlpkdvvfkaydxqygm86nvkm92h"})
+	assert.Nil(t, err)
+	assert.ElementsMatch(t, subs[:2], result)
+
+	assert.Nil(t, setup.Database.DeleteSubscriptions(subs[1:2]))
+	result, err = setup.Database.Lookup(0, []string{"bc1qhn03cww757mnnlpkdvvfkaydxqygm86nvkm92h"})
+	assert.Nil(t, err)
+	assert.Equal(t, subs[:1], result)
+
+	result, err = setup.Database.Lookup(60, []string{"0x5574Cd97432cEd0D7Caf58ac3c4fEDB2061C98fB"})
+	assert.Nil(t, err)
+	assert.Equal(t, subs[2:], result)
+}
+
+func TestSqlStorageTracker(t *testing.T) {
+	height, err := setup.Database.GetBlockNumber(0)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(0), height)
+
+	assert.Nil(t, setup.Database.SetBlockNumber(0, 100))
+	assert.Nil(t, setup.Database.SetBlockNumber(0, 101))
+
+	height, err = setup.Database.GetBlockNumber(0)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(101), height)
+
+	// Migrations are idempotent
+	assert.Nil(t, setup.Database.Migrate())
+}
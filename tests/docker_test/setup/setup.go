@@ -14,6 +14,18 @@ func StopRedisContainer() {
 	}
 }
 
+func RunPostgresContainer() {
+	if err := runPostgresContainer(); err != nil {
+		log.Fatal(err)
+	}
+}
+
+func StopPostgresContainer() {
+	if err := stopPostgresContainer(); err != nil {
+		log.Fatal(err)
+	}
+}
+
 func RunMQContainer() {
 	if err := runMQContainer(); err != nil {
 		log.Fatal(err)
@@ -0,0 +1,73 @@
+// +build integration
+
+package docker_test
+
+import (
+	"fmt"
+	"github.com/stretchr/testify/assert"
+	"github.com/trustwallet/blockatlas/pkg/blockatlas"
+	"github.com/trustwallet/blockatlas/tests/docker_test/setup"
+	"sync"
+	"testing"
+)
+
+const concurrentUpdates = 50
+
+func TestConcurrentAddSubscriptions(t *testing.T) {
+	address := "bc1q2fpry7zwqh575huc9urwfdvjtuvz508wez56ff"
+
+	var wg sync.WaitGroup
+	wg.Add(concurrentUpdates)
+	for i := 0; i < concurrentUpdates; i++ {
+		go func(i int) {
+			defer wg.Done()
+			sub := blockatlas.Subscription{Coin: 0, Address: address, GUID: fmt.Sprintf("guid-%d", i)}
+			assert.Nil(t, setup.Cache.AddSubscriptions([]blockatlas.Subscription{sub}))
+		}(i)
+	}
+	wg.Wait()
+
+	result, err := setup.Cache.Lookup(0, []string{address})
+	assert.Nil(t, err)
+	assert.Len(t, result, concurrentUpdates)
+}
+
+func TestConcurrentDeleteSubscriptions(t *testing.T) {
+	address := "bc1qk3yj6h79qw7tnsg4durc9sd5fpd3qt0p0m8u5p"
+
+	subs := make([]blockatlas.Subscription, 0, concurrentUpdates)
+	for i := 0; i < concurrentUpdates; i++ {
+		subs = append(subs, blockatlas.Subscription{Coin: 0, Address: address, GUID: fmt.Sprintf("guid-%d", i)})
+	}
+	assert.Nil(t, setup.Cache.AddSubscriptions(subs))
+
+	// Delete the even GUIDs while the odd ones are added again
+	var wg sync.WaitGroup
+	wg.Add(concurrentUpdates)
+	for i, sub := range subs {
+		go func(i int, sub blockatlas.Subscription) {
+			defer wg.Done()
+			if i%2 == 0 {
+				assert.Nil(t, setup.Cache.DeleteSubscriptions([]blockatlas.Subscription{sub}))
+				return
+			}
+			assert.Nil(t, setup.Cache.AddSubscriptions([]blockatlas.Subscription{sub}))
+		}(i, sub)
+	}
+	wg.Wait()
+
+	result, err := setup.Cache.Lookup(0, []string{address})
+	assert.Nil(t, err)
+	assert.Len(t, result, concurrentUpdates/2)
+	for _, sub := range result {
+		var i int
+		_, err := fmt.Sscanf(sub.GUID, "guid-%d", &i)
+		assert.Nil(t, err)
+		assert.Equal(t, 1, i%2, sub.GUID)
+	}
+
+	assert.Nil(t, setup.Cache.DeleteSubscriptions(subs))
+	result, err = setup.Cache.Lookup(0, []string{address})
+	assert.Nil(t, err)
+	assert.Empty(t, result)
+}
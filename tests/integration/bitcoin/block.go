@@ -15,7 +15,7 @@ const (
 
 func TestBitcoin(t *testing.T) {
 	t.Run("test bitcoin", func(t *testing.T) {
-		p := bitcoin.Init(coin.BTC, platform.GetApiVar(coin.BTC))
+		p := bitcoin.Init(coin.BTC, platform.GetApiVar(coin.BTC), platform.GetRpcVar(coin.BTC))
 		testCurrentBlockNumber(p, t)
 		testGetBlockByNumber(p, t)
 	})